@@ -0,0 +1,147 @@
+package graph
+
+// adjIndex is an index-based copy of a graph used by the algorithms,
+// which is independent of the underlying representation.
+//
+// Vertices are numbered in the order they were added to the graph,
+// the neighbors of every vertex are deduplicated and keep the order of the representation.
+type adjIndex struct {
+	directed bool
+	names    []string
+	index    map[string]int
+	adj      [][]int
+	// weights[v][k] is the weight of the edge v -> adj[v][k], nil until loadWeights is called.
+	weights [][]float64
+}
+
+// newAdjIndex builds an adjIndex from the graph representation under a single read lock.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+// (O(v^2) for the adjacency matrix).
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func newAdjIndex(gr GraphRepr) (*adjIndex, error) {
+	return gr.index(1)
+}
+
+// newAdjIndexWithEdges builds an adjIndex and lists the edges ordered by ID under a single read lock,
+// so the edges reference only the indexed vertices, e.g. to load the weights.
+//
+// Time complexity: O(v+e*log(e)), where v is number of vertices, and e is number of edges
+// (O(v^2+e*log(e)) for the adjacency matrix).
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func newAdjIndexWithEdges(gr GraphRepr) (*adjIndex, []Edge, error) {
+	return gr.indexWithEdges(1)
+}
+
+// indexVertices builds an adjIndex from the vertices and the lookup of their neighbors,
+// repeated neighbors are skipped.
+//
+// The neighbors are looked up and indexed by the given number of workers concurrently,
+// so the lookup must be safe for concurrent use.
+//
+// Time complexity: O((v+e)/w + v), where v is number of vertices, e is number of edges, and w is number of workers
+//
+// Space complexity: O(v*w+e), where v is number of vertices, e is number of edges, and w is number of workers
+func indexVertices(directed bool, names []string, neighbors func(vertex string) ([]string, error), workers int) (*adjIndex, error) {
+	idx := &adjIndex{
+		directed: directed,
+		names:    names,
+		index:    make(map[string]int, len(names)),
+		adj:      make([][]int, len(names)),
+	}
+
+	for i, name := range names {
+		idx.index[name] = i
+	}
+
+	errs := make([]error, workers)
+	parallelFor(len(names), workers, func(lo, hi, chunk int) {
+		// seen[j] == i+1 if j is already a neighbor of i
+		seen := make([]int, len(names))
+		for i := lo; i < hi; i++ {
+			list, err := neighbors(names[i])
+			if err != nil {
+				errs[chunk] = err
+				return
+			}
+
+			idx.adj[i] = make([]int, 0, len(list))
+			for _, neighbor := range list {
+				j, ok := idx.index[neighbor]
+				if !ok {
+					errs[chunk] = ErrVertexNotFound(neighbor)
+					return
+				}
+				if seen[j] != i+1 {
+					seen[j] = i + 1
+					idx.adj[i] = append(idx.adj[i], j)
+				}
+			}
+		}
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return idx, nil
+}
+
+// len returns the number of vertices.
+func (idx *adjIndex) len() int {
+	return len(idx.names)
+}
+
+// toNames converts vertex indices into vertex names.
+func (idx *adjIndex) toNames(vertices []int) []string {
+	names := make([]string, len(vertices))
+	for i, v := range vertices {
+		names[i] = idx.names[v]
+	}
+	return names
+}
+
+// loadWeights fills the weights of the adjacency, combine merges the weights of parallel edges,
+// e.g. sums them up for random walks or takes the lightest one for shortest paths.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (idx *adjIndex) loadWeights(edges []Edge, combine func(a, b float64) float64) {
+	position := make([]map[int]int, idx.len())
+	idx.weights = make([][]float64, idx.len())
+	for v, neighbors := range idx.adj {
+		position[v] = make(map[int]int, len(neighbors))
+		for k, w := range neighbors {
+			position[v][w] = k
+		}
+		idx.weights[v] = make([]float64, len(neighbors))
+	}
+
+	loaded := make([]map[int]bool, idx.len())
+	set := func(u, v int, weight float64) {
+		if loaded[u] == nil {
+			loaded[u] = make(map[int]bool)
+		}
+
+		k := position[u][v]
+		if loaded[u][k] {
+			idx.weights[u][k] = combine(idx.weights[u][k], weight)
+		} else {
+			loaded[u][k] = true
+			idx.weights[u][k] = weight
+		}
+	}
+
+	for _, edge := range edges {
+		u, v := idx.index[edge.Source], idx.index[edge.Target]
+		set(u, v, edge.Weight)
+		if !idx.directed && u != v {
+			set(v, u, edge.Weight)
+		}
+	}
+}
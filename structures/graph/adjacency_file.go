@@ -0,0 +1,1068 @@
+package graph
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/dkhrunov/dsa-go/structures/queue"
+)
+
+// adjFile is the disk-backed representation, every mutation is appended to the file
+// as a checksummed record and an in-memory index keeps the offsets of the records of the live edges.
+// Only vertex names and edge offsets are kept in memory, edge endpoints and weights are read from the file.
+//
+// Every record is synced to the disk before the mutation is applied, so an applied mutation
+// survives both a process crash and a power loss, at the cost of an fsync per mutation,
+// Graph.Batch syncs the records of many mutations at once.
+// Reopening the file replays its records and truncates the torn or corrupted last one,
+// so a crash loses at most the mutation which was being written.
+// A corrupted record followed by other records fails the replay with ErrFileCorrupted.
+//
+// Read errors are returned by the methods which return an error,
+// other methods skip the edges which can't be read.
+//
+// The file is never compacted: records of deleted vertices and edges stay in it,
+// so the file and the time to reopen it grow with the number of mutations, not with the size of the graph.
+// Copy the graph into a new file to drop them.
+//
+// Space complexity: O(n+m) in memory, where n is number of vertices, m is number of edges,
+// O(r) on the disk, where r is number of mutations
+type adjFile struct {
+	notifier
+	lock sync.RWMutex
+	file *os.File
+	// size is the offset of the next record.
+	size int64
+	// err is the first failed write, the representation rejects mutations after it.
+	err error
+	// batches is the number of running batches, records aren't synced one by one while it is positive.
+	batches int
+	// unsynced tells whether records were written since the last sync.
+	unsynced   bool
+	undirected bool
+	policy     edgePolicy
+	nextID     int
+	order      []string
+	vertices   map[string]*fileVertex
+	// edges maps the ID of an edge to the offset of its record.
+	edges map[int]int64
+}
+
+// fileVertex is the index entry of a vertex.
+type fileVertex struct {
+	// out holds the edges leaving the vertex, in undirected graphs all edges incident to it.
+	out []edgeRef
+	// in holds the edges entering the vertex in directed graphs.
+	in []edgeRef
+}
+
+// edgeRef is the ID of an edge and the offset of its record.
+type edgeRef struct {
+	id     int
+	offset int64
+}
+
+// OpenFile opens the undirected graph stored in the file, the file is created if it doesn't exist.
+//
+// Mutations are written and synced to the file as they are applied, options are applied after the stored graph is loaded.
+// The vertices and the offsets of the edges are indexed in memory, the file is append-only and is never compacted.
+// The graph must be closed with Graph.Close.
+//
+// Returns ErrFileDirectionMismatch if the file stores a directed graph.
+//
+// Time complexity: O(r), where r is number of records in the file
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func OpenFile(path string, opts ...GraphOption) (*Graph, error) {
+	return openFile(path, false, opts...)
+}
+
+// OpenDirectedFile opens the directed graph stored in the file, the file is created if it doesn't exist.
+// See OpenFile for the guarantees of the file.
+//
+// Returns ErrFileDirectionMismatch if the file stores an undirected graph.
+//
+// Time complexity: O(r), where r is number of records in the file
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func OpenDirectedFile(path string, opts ...GraphOption) (*Graph, error) {
+	return openFile(path, true, opts...)
+}
+
+func openFile(path string, directed bool, opts ...GraphOption) (*Graph, error) {
+	f, err := openAdjFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case f.size == 0 && directed:
+		f.setDirected()
+	case f.size > 0 && f.undirected == directed:
+		f.Close()
+		return nil, ErrFileDirectionMismatch
+	}
+
+	g := newGraph(f, opts...)
+	if f.err != nil {
+		f.Close()
+		return nil, f.err
+	}
+
+	return g, nil
+}
+
+func openAdjFile(path string) (*adjFile, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &adjFile{
+		file:       file,
+		undirected: true,
+		vertices:   make(map[string]*fileVertex),
+		edges:      make(map[int]int64),
+	}
+
+	if err := f.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	// Replayed records aren't recorded as events, so the weighted edges are counted once.
+	for _, edge := range f.readEdges() {
+		if edge.Weight != DefaultWeight {
+			f.weighted++
+		}
+	}
+
+	return f, nil
+}
+
+// replay loads the index from the records of the file and truncates its torn tail.
+//
+// Only the last record can be torn by a crash: a record running past the end of the file,
+// a corrupted record ending at the end of the file, or a zero-filled tail left by the file system are truncated.
+// A corrupted record followed by other records returns ErrFileCorrupted, so valid records are never dropped.
+func (f *adjFile) replay() error {
+	info, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	for f.size < info.Size() {
+		record, size, err := readRecord(f.file, f.size, info.Size())
+		if errors.Is(err, errCorruptedRecord) {
+			torn := f.size+size == info.Size()
+			if size == 0 {
+				if torn, err = f.isZeroTail(f.size, info.Size()); err != nil {
+					return err
+				}
+			}
+			if !torn {
+				return fmt.Errorf("replay record at offset %v: %w", f.size, ErrFileCorrupted)
+			}
+			return f.file.Truncate(f.size)
+		}
+		if errors.Is(err, errTornRecord) {
+			return f.file.Truncate(f.size)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := f.apply(record, f.size); err != nil {
+			return fmt.Errorf("replay record at offset %v: %w", f.size, err)
+		}
+		f.size += size
+	}
+
+	return nil
+}
+
+// isZeroTail checks whether the file contains only zero bytes from the offset to the limit.
+func (f *adjFile) isZeroTail(offset, limit int64) (bool, error) {
+	buf := make([]byte, 4096)
+	for offset < limit {
+		n := int64(len(buf))
+		if limit-offset < n {
+			n = limit - offset
+		}
+		if _, err := f.file.ReadAt(buf[:n], offset); err != nil {
+			return false, err
+		}
+		for _, b := range buf[:n] {
+			if b != 0 {
+				return false, nil
+			}
+		}
+		offset += n
+	}
+	return true, nil
+}
+
+// Close flushes the file to the disk and closes it.
+func (f *adjFile) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.file.Sync(); err != nil {
+		f.file.Close()
+		return err
+	}
+
+	return f.file.Close()
+}
+
+// beginBatch stops syncing records one by one until the matching endBatch.
+func (f *adjFile) beginBatch() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.batches++
+}
+
+// endBatch syncs the records written since beginBatch once the last running batch ends.
+func (f *adjFile) endBatch() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.batches--
+	if f.batches > 0 || !f.unsynced {
+		return f.err
+	}
+
+	f.unsynced = false
+	if err := f.file.Sync(); err != nil && f.err == nil {
+		f.err = err
+	}
+	return f.err
+}
+
+// commit appends the record to the file, syncs it to the disk and applies it to the index.
+func (f *adjFile) commit(record fileRecord) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	offset := f.size
+	buf := record.encode()
+	if _, err := f.file.WriteAt(buf, offset); err != nil {
+		f.err = err
+		return err
+	}
+	f.size += int64(len(buf))
+
+	if f.batches > 0 {
+		f.unsynced = true
+	} else if err := f.file.Sync(); err != nil {
+		f.err = err
+		return err
+	}
+
+	if err := f.apply(record, offset); err != nil {
+		f.err = err
+		return err
+	}
+
+	return nil
+}
+
+// apply updates the index with the record written at the offset.
+func (f *adjFile) apply(record fileRecord, offset int64) error {
+	switch record.op {
+	case opSetDirected:
+		f.undirected = false
+	case opSetPolicy:
+		f.policy = record.policy
+	case opAddVertex:
+		f.order = append(f.order, record.vertex)
+		f.vertices[record.vertex] = &fileVertex{}
+	case opDeleteVertex:
+		v, ok := f.vertices[record.vertex]
+		if !ok {
+			return ErrVertexNotFound(record.vertex)
+		}
+		// unlink shrinks the reference lists, so the IDs are collected first
+		ids := make([]int, 0, len(v.out)+len(v.in))
+		for _, refs := range [2][]edgeRef{v.out, v.in} {
+			for _, ref := range refs {
+				ids = append(ids, ref.id)
+			}
+		}
+		for _, id := range ids {
+			// A self-loop is referenced twice in directed graphs
+			if _, ok := f.edges[id]; !ok {
+				continue
+			}
+			if err := f.unlink(id); err != nil {
+				return err
+			}
+		}
+		delete(f.vertices, record.vertex)
+		for i, vertex := range f.order {
+			if vertex == record.vertex {
+				f.order = append(f.order[:i], f.order[i+1:]...)
+				break
+			}
+		}
+	case opAddEdge:
+		return f.link(record.edge, offset)
+	case opDeleteEdges:
+		for _, id := range record.ids {
+			if err := f.unlink(id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *adjFile) link(edge Edge, offset int64) error {
+	source, ok := f.vertices[edge.Source]
+	if !ok {
+		return ErrVertexNotFound(edge.Source)
+	}
+
+	target, ok := f.vertices[edge.Target]
+	if !ok {
+		return ErrVertexNotFound(edge.Target)
+	}
+
+	ref := edgeRef{id: edge.ID, offset: offset}
+	source.out = append(source.out, ref)
+	if !f.undirected {
+		target.in = append(target.in, ref)
+	} else if source != target {
+		// A self-loop is stored once
+		target.out = append(target.out, ref)
+	}
+
+	f.edges[edge.ID] = offset
+	if edge.ID >= f.nextID {
+		f.nextID = edge.ID + 1
+	}
+
+	return nil
+}
+
+func (f *adjFile) unlink(id int) error {
+	offset, ok := f.edges[id]
+	if !ok {
+		return ErrEdgeIDNotFound(id)
+	}
+
+	edge, err := f.readEdge(offset)
+	if err != nil {
+		return err
+	}
+
+	source, target := f.vertices[edge.Source], f.vertices[edge.Target]
+	source.out = removeEdgeRef(source.out, id)
+	if f.undirected {
+		target.out = removeEdgeRef(target.out, id)
+	} else {
+		target.in = removeEdgeRef(target.in, id)
+	}
+
+	delete(f.edges, id)
+
+	return nil
+}
+
+func removeEdgeRef(refs []edgeRef, id int) []edgeRef {
+	for i, ref := range refs {
+		if ref.id == id {
+			return append(refs[:i], refs[i+1:]...)
+		}
+	}
+	return refs
+}
+
+// readEdge reads the edge from its record at the offset.
+func (f *adjFile) readEdge(offset int64) (Edge, error) {
+	record, _, err := readRecord(f.file, offset, f.size)
+	if err != nil {
+		return Edge{}, err
+	}
+	if record.op != opAddEdge {
+		return Edge{}, errCorruptedRecord
+	}
+	return record.edge, nil
+}
+
+// incident returns the edges leaving the vertex, in undirected graphs all edges incident to it.
+func (f *adjFile) incident(vertex string) ([]Edge, error) {
+	v, ok := f.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	edges := make([]Edge, 0, len(v.out))
+	for _, ref := range v.out {
+		edge, err := f.readEdge(ref.offset)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
+
+// neighbors returns the distinct vertices adjacent to the vertex in the order their edges were added.
+func (f *adjFile) neighbors(vertex string) ([]string, error) {
+	edges, err := f.incident(vertex)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(edges))
+	neighbors := make([]string, 0, len(edges))
+	for _, edge := range edges {
+		name := edge.Target
+		if f.undirected && name == vertex {
+			name = edge.Source
+		}
+		if !seen[name] {
+			seen[name] = true
+			neighbors = append(neighbors, name)
+		}
+	}
+
+	return neighbors, nil
+}
+
+// connecting returns the edges between source and target.
+func (f *adjFile) connecting(source, target string) (map[int]Edge, error) {
+	if _, ok := f.vertices[source]; !ok {
+		return nil, ErrVertexNotFound(source)
+	}
+
+	if _, ok := f.vertices[target]; !ok {
+		return nil, ErrVertexNotFound(target)
+	}
+
+	edges, err := f.incident(source)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]Edge)
+	for _, edge := range edges {
+		if edge.connects(source, target, f.undirected) {
+			result[edge.ID] = edge
+		}
+	}
+
+	return result, nil
+}
+
+func (f *adjFile) setDirected() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.undirected {
+		f.commit(fileRecord{op: opSetDirected})
+	}
+}
+
+func (f *adjFile) setEdgePolicy(policy edgePolicy) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.policy != policy {
+		f.commit(fileRecord{op: opSetPolicy, policy: policy})
+	}
+}
+
+func (f *adjFile) edgePolicy() edgePolicy {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.policy
+}
+
+func (f *adjFile) IsDirected() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return !f.undirected
+}
+
+func (f *adjFile) Vertices() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return len(f.order)
+}
+
+func (f *adjFile) Edges() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return len(f.edges)
+}
+
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (f *adjFile) HasVertex(vertex string) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	_, has := f.vertices[vertex]
+	return has
+}
+
+// ListVertices returns the vertices in the order they were added.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (f *adjFile) ListVertices() []string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return append([]string(nil), f.order...)
+}
+
+// Neighbors returns the distinct vertices adjacent to the given vertex.
+//
+// Time complexity: O(d), where d is degree of the vertex (each edge is read from the file)
+//
+// Space complexity: O(d), where d is degree of the vertex
+func (f *adjFile) Neighbors(vertex string) ([]string, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.neighbors(vertex)
+}
+
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (f *adjFile) AddVertex(vertex string) error {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	if _, ok := f.vertices[vertex]; ok {
+		return ErrVertexAlreadyExists(vertex)
+	}
+
+	if err := f.commit(fileRecord{op: opAddVertex, vertex: vertex}); err != nil {
+		return err
+	}
+
+	f.record(Event{Type: VertexAdded, Vertex: vertex})
+
+	return nil
+}
+
+// Time complexity: O(n+d), where n is number of vertices, d is degree of the vertex
+//
+// Space complexity: O(d), where d is degree of the vertex
+func (f *adjFile) DeleteVertex(vertex string) error {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	v, ok := f.vertices[vertex]
+	if !ok {
+		return ErrVertexNotFound(vertex)
+	}
+
+	deleted := make(map[int]Edge)
+	for _, refs := range [2][]edgeRef{v.out, v.in} {
+		for _, ref := range refs {
+			edge, err := f.readEdge(ref.offset)
+			if err != nil {
+				return err
+			}
+			deleted[edge.ID] = edge
+		}
+	}
+
+	if err := f.commit(fileRecord{op: opDeleteVertex, vertex: vertex}); err != nil {
+		return err
+	}
+
+	f.recordDeletedEdges(deleted)
+	f.record(Event{Type: VertexDeleted, Vertex: vertex})
+
+	return nil
+}
+
+// Time complexity: O(d), where d is degree of the source
+//
+// Space complexity: O(d), where d is degree of the source
+func (f *adjFile) HasEdge(source, target string) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	edges, err := f.connecting(source, target)
+	return err == nil && len(edges) > 0
+}
+
+// Time complexity: O(d), where d is degree of the source
+//
+// Space complexity: O(d), where d is degree of the source
+func (f *adjFile) AddEdge(source, target string) error {
+	_, err := f.AddEdgeWithID(source, target)
+	return err
+}
+
+// AddEdgeWithID adds the edge with DefaultWeight and returns its ID.
+//
+// Time complexity: O(d), where d is degree of the source
+//
+// Space complexity: O(d), where d is degree of the source
+func (f *adjFile) AddEdgeWithID(source, target string) (int, error) {
+	return f.AddWeightedEdge(source, target, DefaultWeight)
+}
+
+// AddWeightedEdge adds the edge with the given weight and returns its ID.
+//
+// Time complexity: O(d), where d is degree of the source
+//
+// Space complexity: O(d), where d is degree of the source
+func (f *adjFile) AddWeightedEdge(source, target string, weight float64) (int, error) {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	id := f.nextID
+	if err := f.addEdge(Edge{ID: id, Source: source, Target: target, Weight: weight}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// restoreEdge adds the previously deleted edge with its original ID.
+func (f *adjFile) restoreEdge(edge Edge) error {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	if _, ok := f.edges[edge.ID]; ok {
+		return ErrEdgeAlreadyExists(edge.Source, edge.Target)
+	}
+
+	return f.addEdge(edge)
+}
+
+func (f *adjFile) addEdge(edge Edge) error {
+	existing, err := f.connecting(edge.Source, edge.Target)
+	if err != nil {
+		return err
+	}
+
+	if err := f.policy.checkEdge(edge.Source, edge.Target, len(existing) > 0); err != nil {
+		return err
+	}
+
+	if err := f.commit(fileRecord{op: opAddEdge, edge: edge}); err != nil {
+		return err
+	}
+
+	f.record(Event{Type: EdgeAdded, Edge: edge})
+
+	return nil
+}
+
+// DeleteEdge removes all edges between source and target.
+//
+// Time complexity: O(d), where d is degree of the source
+//
+// Space complexity: O(d), where d is degree of the source
+func (f *adjFile) DeleteEdge(source, target string) error {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	deleted, err := f.connecting(source, target)
+	if err != nil {
+		return err
+	}
+
+	if len(deleted) == 0 {
+		return ErrEdgeNotFound(source, target)
+	}
+
+	ids := make([]int, 0, len(deleted))
+	for _, edge := range sortedEdges(deleted) {
+		ids = append(ids, edge.ID)
+	}
+
+	if err := f.commit(fileRecord{op: opDeleteEdges, ids: ids}); err != nil {
+		return err
+	}
+
+	f.recordDeletedEdges(deleted)
+
+	return nil
+}
+
+// DeleteEdgeByID removes the edge with the given ID.
+//
+// Time complexity: O(d), where d is degree of the endpoints
+//
+// Space complexity: O(1)
+func (f *adjFile) DeleteEdgeByID(id int) error {
+	f.lock.Lock()
+	defer f.unlockAndNotify(&f.lock)
+
+	offset, ok := f.edges[id]
+	if !ok {
+		return ErrEdgeIDNotFound(id)
+	}
+
+	edge, err := f.readEdge(offset)
+	if err != nil {
+		return err
+	}
+
+	if err := f.commit(fileRecord{op: opDeleteEdges, ids: []int{id}}); err != nil {
+		return err
+	}
+
+	f.record(Event{Type: EdgeDeleted, Edge: edge})
+
+	return nil
+}
+
+// ListEdges returns all edges ordered by ID.
+//
+// Time complexity: O(m*log(m)), where m is number of edges
+//
+// Space complexity: O(m), where m is number of edges
+func (f *adjFile) ListEdges() []Edge {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return sortedEdges(f.readEdges())
+}
+
+// readEdges reads all live edges, skipping the ones which can't be read.
+func (f *adjFile) readEdges() map[int]Edge {
+	edges := make(map[int]Edge, len(f.edges))
+	for id, offset := range f.edges {
+		if edge, err := f.readEdge(offset); err == nil {
+			edges[id] = edge
+		}
+	}
+	return edges
+}
+
+// clone returns an in-memory adjacency list copy of the representation without listeners.
+//
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (f *adjFile) clone() GraphRepr {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	c := newAdjList()
+	c.undirected = f.undirected
+	c.policy = f.policy
+
+	for _, vertex := range f.order {
+		c.vertices[vertex] = c.v
+		c.lists = append(c.lists, list.New())
+		c.in = append(c.in, list.New())
+		c.v++
+	}
+	for _, edge := range sortedEdges(f.readEdges()) {
+		c.addEdge(edge)
+	}
+	c.nextID = f.nextID
+	// Events recorded by addEdge belong to no one
+	c.pending = nil
+
+	return c
+}
+
+// index builds the adjIndex of the graph under a single read lock,
+// so the algorithms see a consistent view of the graph, the neighbors are indexed by the given number of workers.
+//
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges (each edge is read from the file)
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (f *adjFile) index(workers int) (*adjIndex, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return indexVertices(!f.undirected, append([]string(nil), f.order...), f.neighbors, workers)
+}
+
+// indexWithEdges builds the adjIndex and lists the edges ordered by ID under a single read lock,
+// so the weights of the edges match the indexed vertices.
+//
+// Time complexity: O(n+m*log(m)), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (f *adjFile) indexWithEdges(workers int) (*adjIndex, []Edge, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	idx, err := indexVertices(!f.undirected, append([]string(nil), f.order...), f.neighbors, workers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return idx, sortedEdges(f.readEdges()), nil
+}
+
+// arcs returns the neighbors of the vertex with the lightest weight of the edges to them,
+// the edges are followed backwards if reverse is set.
+//
+// Time complexity: O(d), where d is degree of the vertex
+//
+// Space complexity: O(d), where d is degree of the vertex
+func (f *adjFile) arcs(vertex string, reverse bool) ([]arc, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	v, ok := f.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	refs := v.out
+	if reverse && !f.undirected {
+		refs = v.in
+	}
+
+	arcs := make([]arc, 0, len(refs))
+	seen := make(map[string]int, len(refs))
+	for _, ref := range refs {
+		edge, err := f.readEdge(ref.offset)
+		if err != nil {
+			return nil, err
+		}
+
+		name := edge.Target
+		if (reverse && !f.undirected) || (f.undirected && name == vertex) {
+			name = edge.Source
+		}
+		arcs = appendArc(arcs, seen, name, edge.Weight)
+	}
+
+	return arcs, nil
+}
+
+// unweighted tells whether all edges have DefaultWeight.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (f *adjFile) unweighted() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.weighted == 0
+}
+
+// snapshot returns the in-memory persistent copy of the graph shared by its snapshots.
+//
+// Time complexity: O(1), the first call is O((n+m)*log(n+m)), where n is number of vertices, m is number of edges
+// (each edge is read from the file)
+//
+// Space complexity: O(1), the first call is O(n+m), where n is number of vertices, m is number of edges
+func (f *adjFile) snapshot() frozenGraph {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.notifier.snapshot(func() *frozenGraph {
+		return freeze(!f.undirected, append([]string(nil), f.order...), sortedEdges(f.readEdges()))
+	})
+}
+
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (f *adjFile) BFS(start string, callback func(vertex string)) error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if _, ok := f.vertices[start]; !ok {
+		return ErrVertexNotFound(start)
+	}
+
+	visited := make(map[string]bool, len(f.order))
+	queue := queue.New()
+
+	visited[start] = true
+	queue.EnQueue(start)
+
+	for queue.Len() > 0 {
+		curr := queue.DeQueue().(string)
+		callback(curr)
+
+		neighbors, err := f.neighbors(curr)
+		if err != nil {
+			return err
+		}
+
+		for _, vertex := range neighbors {
+			if !visited[vertex] {
+				visited[vertex] = true
+				queue.EnQueue(vertex)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (f *adjFile) DFS(start string, callback func(vertex string)) error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if _, ok := f.vertices[start]; !ok {
+		return ErrVertexNotFound(start)
+	}
+
+	visited := make(map[string]bool, len(f.order))
+	return f.dfs(start, callback, visited)
+}
+
+func (f *adjFile) dfs(vertex string, callback func(vertex string), visited map[string]bool) error {
+	visited[vertex] = true
+	callback(vertex)
+
+	neighbors, err := f.neighbors(vertex)
+	if err != nil {
+		return err
+	}
+
+	for _, next := range neighbors {
+		if !visited[next] {
+			if err := f.dfs(next, callback, visited); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsCyclic skips the edges which can't be read, like the other methods which don't return an error.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (f *adjFile) IsCyclic() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	edges := f.readEdges()
+	if f.undirected {
+		return hasUndirectedCycle(edges)
+	}
+
+	out := make(map[string][]string, len(f.order))
+	for _, edge := range edges {
+		out[edge.Source] = append(out[edge.Source], edge.Target)
+	}
+
+	visited := make(map[string]bool, len(f.order))
+	recMap := make(map[string]bool, len(f.order))
+
+	for _, vertex := range f.order {
+		if !visited[vertex] && isCyclicRec(out, vertex, visited, recMap) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isCyclicRec checks whether a cycle is reachable from the vertex following the edges of out.
+func isCyclicRec(out map[string][]string, vertex string, visited, recMap map[string]bool) bool {
+	visited[vertex] = true
+	recMap[vertex] = true
+
+	for _, v := range out[vertex] {
+		if recMap[v] {
+			return true
+		}
+		if !visited[v] && isCyclicRec(out, v, visited, recMap) {
+			return true
+		}
+	}
+
+	// Remove the vertex from recursion stack
+	recMap[vertex] = false
+	return false
+}
+
+// FindComponents returns the components ordered by their first vertex,
+// vertices of a component are in the DFS order.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (f *adjFile) FindComponents() ([]*list.List, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	components := make([]*list.List, 0)
+	visited := make(map[string]bool, len(f.order))
+
+	for _, vertex := range f.order {
+		if visited[vertex] {
+			continue
+		}
+
+		component := list.New()
+		grouping := func(vertex string) {
+			component.PushBack(vertex)
+		}
+		if err := f.dfs(vertex, grouping, visited); err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (f *adjFile) String() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if len(f.order) == 0 {
+		return "[]"
+	}
+
+	var buffer bytes.Buffer
+	for _, vertex := range f.order {
+		buffer.WriteString(fmt.Sprintf("%v [", vertex))
+		for i, ref := range f.vertices[vertex].out {
+			edge, err := f.readEdge(ref.offset)
+			if err != nil {
+				continue
+			}
+			name := edge.Target
+			if f.undirected && name == vertex {
+				name = edge.Source
+			}
+			if i > 0 {
+				buffer.WriteString(", ")
+			}
+			buffer.WriteString(name)
+		}
+		buffer.WriteString("]\n")
+	}
+
+	return buffer.String()
+}
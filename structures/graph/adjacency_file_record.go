@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+// Operations of the records of the file-backed representation.
+const (
+	opSetDirected byte = iota + 1
+	opSetPolicy
+	opAddVertex
+	opDeleteVertex
+	opAddEdge
+	opDeleteEdges
+)
+
+// frameHeaderSize is the size of the record length and its CRC-32 checksum, which precede every record.
+const frameHeaderSize = 8
+
+var (
+	// errTornRecord is returned for a record running past the end of the file.
+	errTornRecord = errors.New("torn record")
+	// errCorruptedRecord is returned for a record failing the checksum or the decoding.
+	errCorruptedRecord = errors.New("corrupted record")
+)
+
+// fileRecord is a mutation stored in the append-only file.
+type fileRecord struct {
+	op     byte
+	vertex string
+	edge   Edge
+	ids    []int
+	policy edgePolicy
+}
+
+// encode returns the record framed with its length and checksum.
+func (r fileRecord) encode() []byte {
+	payload := []byte{r.op}
+	switch r.op {
+	case opSetPolicy:
+		var flags byte
+		if r.policy.parallelEdges {
+			flags |= 1
+		}
+		if r.policy.selfLoops {
+			flags |= 2
+		}
+		payload = append(payload, flags)
+	case opAddVertex, opDeleteVertex:
+		payload = appendString(payload, r.vertex)
+	case opAddEdge:
+		payload = binary.AppendVarint(payload, int64(r.edge.ID))
+		payload = appendString(payload, r.edge.Source)
+		payload = appendString(payload, r.edge.Target)
+		payload = binary.LittleEndian.AppendUint64(payload, math.Float64bits(r.edge.Weight))
+	case opDeleteEdges:
+		payload = binary.AppendUvarint(payload, uint64(len(r.ids)))
+		for _, id := range r.ids {
+			payload = binary.AppendVarint(payload, int64(id))
+		}
+	}
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(frame, uint32(len(payload)))
+	binary.LittleEndian.PutUint32(frame[4:], crc32.ChecksumIEEE(payload))
+	return append(frame, payload...)
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// readRecord reads the record at the offset and returns it with the size of its frame,
+// the record must end before the limit.
+//
+// A record running past the limit, e.g. left by a crash in the middle of a write, returns errTornRecord.
+// A record failing the checksum or the decoding returns errCorruptedRecord with the size of its frame,
+// the size is 0 if the frame has no length.
+func readRecord(r io.ReaderAt, offset, limit int64) (fileRecord, int64, error) {
+	if offset+frameHeaderSize > limit {
+		return fileRecord{}, 0, errTornRecord
+	}
+
+	header := make([]byte, frameHeaderSize)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		if err == io.EOF {
+			return fileRecord{}, 0, errTornRecord
+		}
+		return fileRecord{}, 0, err
+	}
+
+	length := int64(binary.LittleEndian.Uint32(header))
+	if length == 0 {
+		return fileRecord{}, 0, errCorruptedRecord
+	}
+	size := frameHeaderSize + length
+	if offset+size > limit {
+		return fileRecord{}, 0, errTornRecord
+	}
+
+	payload := make([]byte, length)
+	if _, err := r.ReadAt(payload, offset+frameHeaderSize); err != nil {
+		if err == io.EOF {
+			return fileRecord{}, 0, errTornRecord
+		}
+		return fileRecord{}, 0, err
+	}
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[4:]) {
+		return fileRecord{}, size, errCorruptedRecord
+	}
+
+	d := decoder{buf: payload[1:]}
+	record := fileRecord{op: payload[0]}
+	switch record.op {
+	case opSetDirected:
+	case opSetPolicy:
+		flags := d.byte()
+		record.policy = edgePolicy{parallelEdges: flags&1 != 0, selfLoops: flags&2 != 0}
+	case opAddVertex, opDeleteVertex:
+		record.vertex = d.string()
+	case opAddEdge:
+		record.edge.ID = int(d.varint())
+		record.edge.Source = d.string()
+		record.edge.Target = d.string()
+		record.edge.Weight = math.Float64frombits(d.uint64())
+	case opDeleteEdges:
+		n := d.uvarint()
+		for i := uint64(0); i < n && d.err == nil; i++ {
+			record.ids = append(record.ids, int(d.varint()))
+		}
+	default:
+		return fileRecord{}, size, errCorruptedRecord
+	}
+	if d.err != nil {
+		return fileRecord{}, size, errCorruptedRecord
+	}
+
+	return record, size, nil
+}
+
+// decoder reads the fields of a record payload, the first error is kept in err.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil || len(d.buf) < 1 {
+		d.err = errCorruptedRecord
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if d.err != nil || n <= 0 {
+		d.err = errCorruptedRecord
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if d.err != nil || n <= 0 {
+		d.err = errCorruptedRecord
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) uint64() uint64 {
+	if d.err != nil || len(d.buf) < 8 {
+		d.err = errCorruptedRecord
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n, size := binary.Uvarint(d.buf)
+	if d.err != nil || size <= 0 || uint64(len(d.buf)-size) < n {
+		d.err = errCorruptedRecord
+		return ""
+	}
+	s := string(d.buf[size : size+int(n)])
+	d.buf = d.buf[size+int(n):]
+	return s
+}
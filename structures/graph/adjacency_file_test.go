@@ -0,0 +1,245 @@
+package graph
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// openTempFile opens a file-backed graph stored in the temporary directory of the test,
+// the graph is closed when the test ends.
+func openTempFile(t *testing.T, open func(path string, opts ...GraphOption) (*Graph, error), opts ...GraphOption) *Graph {
+	t.Helper()
+	g, err := open(filepath.Join(t.TempDir(), "graph"), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, g.Close())
+	})
+	return g
+}
+
+func TestOpenFileReopen(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "graph")
+
+	g, err := OpenDirectedFile(path,
+		AllowParallelEdges(),
+		WithVertices([]string{"A", "B", "C", "D", "E"}),
+		WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"}}),
+	)
+	require.NoError(t, err)
+
+	_, err = g.AddWeightedEdge("A", "B", 2.5)
+	require.NoError(t, err)
+	require.NoError(t, g.DeleteEdge("C", "D"))
+	require.NoError(t, g.DeleteVertex("E"))
+	require.NoError(t, g.AddVertex("F"))
+	require.NoError(t, g.Close())
+
+	g, err = OpenDirectedFile(path)
+	require.NoError(t, err)
+	defer g.Close()
+
+	require.True(t, g.IsDirected())
+	require.Equal(t, []string{"A", "B", "C", "D", "F"}, g.ListVertices())
+	require.Equal(t, []Edge{
+		{ID: 0, Source: "A", Target: "B", Weight: DefaultWeight},
+		{ID: 1, Source: "B", Target: "C", Weight: DefaultWeight},
+		{ID: 4, Source: "A", Target: "B", Weight: 2.5},
+	}, g.ListEdges())
+
+	// The edge policy and the next edge ID survive reopening.
+	id, err := g.AddEdgeWithID("A", "B")
+	require.NoError(t, err)
+	require.Equal(t, 5, id)
+
+	var visited []string
+	require.NoError(t, g.BFS("A", func(vertex string) {
+		visited = append(visited, vertex)
+	}))
+	require.Equal(t, []string{"A", "B", "C"}, visited)
+}
+
+func TestOpenFileDirectionMismatch(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "graph")
+
+	g, err := OpenFile(path, WithVertices([]string{"A"}))
+	require.NoError(t, err)
+	require.NoError(t, g.Close())
+
+	_, err = OpenDirectedFile(path)
+	require.ErrorIs(t, err, ErrFileDirectionMismatch)
+}
+
+func TestOpenFileCrashSafety(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		// damage corrupts the file, whose last record starts at the offset.
+		damage func(t *testing.T, path string, offset int64)
+		// kept tells whether the last record survives the damage.
+		kept bool
+	}{
+		{
+			name: "torn record",
+			damage: func(t *testing.T, path string, offset int64) {
+				require.NoError(t, os.Truncate(path, offset+frameHeaderSize+1))
+			},
+		},
+		{
+			name: "corrupted checksum",
+			damage: func(t *testing.T, path string, offset int64) {
+				file, err := os.OpenFile(path, os.O_RDWR, 0)
+				require.NoError(t, err)
+				defer file.Close()
+				_, err = file.WriteAt([]byte{0xff}, offset+frameHeaderSize+1)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "garbage tail",
+			damage: func(t *testing.T, path string, offset int64) {
+				file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0)
+				require.NoError(t, err)
+				defer file.Close()
+				_, err = file.Write([]byte{0x10, 0, 0, 0, 1, 2})
+				require.NoError(t, err)
+			},
+			kept: true,
+		},
+		{
+			name: "zero-filled tail",
+			damage: func(t *testing.T, path string, offset int64) {
+				file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0)
+				require.NoError(t, err)
+				defer file.Close()
+				_, err = file.Write(make([]byte, 64))
+				require.NoError(t, err)
+			},
+			kept: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "graph")
+
+			g, err := OpenFile(path, WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}}))
+			require.NoError(t, err)
+			require.NoError(t, g.Close())
+
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+
+			g, err = OpenFile(path)
+			require.NoError(t, err)
+			require.NoError(t, g.AddEdge("B", "C"))
+			require.NoError(t, g.Close())
+
+			tt.damage(t, path, info.Size())
+
+			g, err = OpenFile(path)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "B", "C"}, g.ListVertices())
+			require.True(t, g.HasEdge("A", "B"))
+			require.Equal(t, tt.kept, g.HasEdge("B", "C"))
+
+			// The damaged tail is dropped, so new records are readable after reopening.
+			require.NoError(t, g.AddEdge("A", "C"))
+			require.NoError(t, g.Close())
+
+			g, err = OpenFile(path)
+			require.NoError(t, err)
+			defer g.Close()
+			require.True(t, g.HasEdge("A", "C"))
+		})
+	}
+}
+
+func TestOpenFileCorrupted(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "graph")
+
+	g, err := OpenFile(path, WithVertices([]string{"A", "B"}))
+	require.NoError(t, err)
+	require.NoError(t, g.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	g, err = OpenFile(path)
+	require.NoError(t, err)
+	require.NoError(t, g.AddEdge("A", "B"))
+	require.NoError(t, g.AddVertex("C"))
+	require.NoError(t, g.Close())
+
+	// Corrupt the record of the edge, which is followed by the record of the vertex C.
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	require.NoError(t, err)
+	_, err = file.WriteAt([]byte{0xff}, info.Size()+frameHeaderSize+1)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	_, err = OpenFile(path)
+	require.ErrorIs(t, err, ErrFileCorrupted)
+
+	// The file isn't truncated, so the records after the corrupted one are kept.
+	corrupted, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Greater(t, corrupted.Size(), info.Size())
+}
+
+func TestGraphBatch(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t)
+
+			require.NoError(t, g.Batch(func(g *Graph) error {
+				for _, vertex := range []string{"A", "B", "C"} {
+					if err := g.AddVertex(vertex); err != nil {
+						return err
+					}
+				}
+				return g.AddEdge("A", "B")
+			}))
+			require.EqualError(t, g.Batch(func(g *Graph) error {
+				return g.AddVertex("A")
+			}), ErrVertexAlreadyExists("A").Error())
+
+			require.Equal(t, []string{"A", "B", "C"}, g.ListVertices())
+			require.True(t, g.HasEdge("A", "B"))
+		})
+	}
+}
+
+func TestOpenFileBatch(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "graph")
+
+	g, err := OpenDirectedFile(path)
+	require.NoError(t, err)
+	require.NoError(t, g.Batch(func(g *Graph) error {
+		for _, vertex := range []string{"A", "B", "C"} {
+			if err := g.AddVertex(vertex); err != nil {
+				return err
+			}
+		}
+		return g.AddEdge("A", "B")
+	}))
+	require.NoError(t, g.Close())
+
+	// The mutations of the batch are synced once it ends.
+	g, err = OpenDirectedFile(path)
+	require.NoError(t, err)
+	defer g.Close()
+	require.Equal(t, []string{"A", "B", "C"}, g.ListVertices())
+	require.True(t, g.HasEdge("A", "B"))
+}
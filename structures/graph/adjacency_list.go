@@ -15,18 +15,25 @@ import (
 // But, in the worst case of a complete graph, which contains n^2 edges,
 // the time and space complexities reduce to O(n^2)
 type adjList struct {
+	notifier
 	lock       sync.RWMutex
 	v          int
-	e          int
 	undirected bool
+	policy     edgePolicy
+	nextID     int
 	vertices   map[string]int
 	lists      []*list.List
+	// in holds the edges entering the vertices of directed graphs, the nodes name the sources.
+	in    []*list.List
+	edges map[int]Edge
 }
 
-// TODO
+// listNode is an element of the adjacency list,
+// the edge to the vertex 'name' identified by 'id'.
 type listNode struct {
 	name   string
-	weight int
+	id     int
+	weight float64
 }
 
 type vertexIdx struct {
@@ -34,15 +41,13 @@ type vertexIdx struct {
 	Index  int
 }
 
-func newAdjList(opts ...GraphOption) *adjList {
+func newAdjList() *adjList {
 	list := &adjList{
 		undirected: true,
 		vertices:   make(map[string]int),
 		lists:      make([]*list.List, 0),
-	}
-
-	for _, opt := range opts {
-		opt(list)
+		in:         make([]*list.List, 0),
+		edges:      make(map[int]Edge),
 	}
 
 	return list
@@ -55,6 +60,20 @@ func (l *adjList) setDirected() {
 	l.undirected = false
 }
 
+func (l *adjList) setEdgePolicy(policy edgePolicy) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.policy = policy
+}
+
+func (l *adjList) edgePolicy() edgePolicy {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.policy
+}
+
 func (l *adjList) IsDirected() bool {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
@@ -73,7 +92,7 @@ func (l *adjList) Edges() int {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
 
-	return l.e
+	return len(l.edges)
 }
 
 func (l *adjList) vertexIdx() []vertexIdx {
@@ -100,12 +119,56 @@ func (l *adjList) HasVertex(vertex string) bool {
 	return has
 }
 
+// ListVertices returns the vertices in the order they were added.
+//
+// Time complexity: O(n*log(n)), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (l *adjList) ListVertices() []string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	vertices := make([]string, 0, l.v)
+	for _, vIdx := range l.vertexIdx() {
+		vertices = append(vertices, vIdx.Vertex)
+	}
+
+	return vertices
+}
+
+// Neighbors returns the distinct vertices adjacent to the given vertex.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (l *adjList) Neighbors(vertex string) ([]string, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	i, ok := l.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	seen := make(map[string]bool, l.lists[i].Len())
+	neighbors := make([]string, 0, l.lists[i].Len())
+	for e := l.lists[i].Front(); e != nil; e = e.Next() {
+		name := e.Value.(listNode).name
+		if !seen[name] {
+			seen[name] = true
+			neighbors = append(neighbors, name)
+		}
+	}
+
+	return neighbors, nil
+}
+
 // Time complexity: O(n), where n is number of vertices
 //
 // Space complexity: O(1)
 func (l *adjList) AddVertex(vertex string) error {
 	l.lock.Lock()
-	defer l.lock.Unlock()
+	defer l.unlockAndNotify(&l.lock)
 
 	if _, ok := l.vertices[vertex]; ok {
 		return ErrVertexAlreadyExists(vertex)
@@ -116,35 +179,47 @@ func (l *adjList) AddVertex(vertex string) error {
 	l.vertices[vertex] = nextIdx
 	// Add new list
 	l.lists = append(l.lists, list.New())
+	l.in = append(l.in, list.New())
 
 	l.v++
 
+	l.record(Event{Type: VertexAdded, Vertex: vertex})
+
 	return nil
 }
 
-// Time complexity: O(n^2), where n is number of vertices
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges
 //
 // Space complexity: O(1)
 func (l *adjList) DeleteVertex(vertex string) error {
 	l.lock.Lock()
-	defer l.lock.Unlock()
-
-	// Delete all edges associated with that vertex
-	for _, list := range l.lists {
-		for e := list.Front(); e != nil; e = e.Next() {
-			if e.Value == vertex {
-				list.Remove(e)
-			}
-		}
-	}
+	defer l.unlockAndNotify(&l.lock)
 
 	vertexIdx, ok := l.vertices[vertex]
 	if !ok {
 		return ErrVertexNotFound(vertex)
 	}
 
+	// Delete all edges associated with that vertex
+	for i := range l.lists {
+		for _, list := range [2]*list.List{l.lists[i], l.in[i]} {
+			removeListNodes(list, func(node listNode) bool {
+				return node.name == vertex
+			})
+		}
+	}
+	deleted := make(map[int]Edge)
+	for id, edge := range l.edges {
+		if edge.Source == vertex || edge.Target == vertex {
+			deleted[id] = edge
+			delete(l.edges, id)
+		}
+	}
+	l.recordDeletedEdges(deleted)
+
 	// Delete from slice
 	l.lists = append(l.lists[:vertexIdx], l.lists[vertexIdx+1:]...)
+	l.in = append(l.in[:vertexIdx], l.in[vertexIdx+1:]...)
 
 	// Delete from map
 	delete(l.vertices, vertex)
@@ -156,6 +231,8 @@ func (l *adjList) DeleteVertex(vertex string) error {
 
 	l.v--
 
+	l.record(Event{Type: VertexDeleted, Vertex: vertex})
+
 	return nil
 }
 
@@ -166,112 +243,346 @@ func (l *adjList) HasEdge(source, target string) bool {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
 
+	return l.hasEdge(source, target)
+}
+
+func (l *adjList) hasEdge(source, target string) bool {
 	i, ok := l.vertices[source]
 	if !ok {
 		return false
 	}
 
-	j, ok := l.vertices[target]
-	if !ok {
+	if _, ok := l.vertices[target]; !ok {
 		return false
 	}
 
-	if l.undirected {
-		sourceToTarget := false
-		for e := l.lists[i].Front(); e != nil; e = e.Next() {
-			if e.Value.(string) == target {
-				sourceToTarget = true
-			}
+	// Undirected edges are stored in the lists of both vertices,
+	// so checking the source's list is enough.
+	for e := l.lists[i].Front(); e != nil; e = e.Next() {
+		if e.Value.(listNode).name == target {
+			return true
 		}
+	}
 
-		targetToSource := false
-		for e := l.lists[j].Front(); e != nil; e = e.Next() {
-			if e.Value.(string) == source {
-				targetToSource = true
-			}
-		}
+	return false
+}
 
-		return sourceToTarget && targetToSource
-	} else {
-		sourceToTarget := false
-		for e := l.lists[i].Front(); e != nil; e = e.Next() {
-			if e.Value.(string) == target {
-				sourceToTarget = true
-			}
-		}
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(1)
+func (l *adjList) AddEdge(source, target string) error {
+	_, err := l.AddEdgeWithID(source, target)
+	return err
+}
 
-		return sourceToTarget
-	}
+// AddEdgeWithID adds the edge with DefaultWeight and returns its ID.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(1)
+func (l *adjList) AddEdgeWithID(source, target string) (int, error) {
+	return l.AddWeightedEdge(source, target, DefaultWeight)
 }
 
+// AddWeightedEdge adds the edge with the given weight and returns its ID.
+//
 // Time complexity: O(n), where n is number of vertices
 //
 // Space complexity: O(1)
-func (l *adjList) AddEdge(source, target string) error {
-	if ok := l.HasEdge(source, target); ok {
-		return ErrEdgeAlreadyExists(source, target)
+func (l *adjList) AddWeightedEdge(source, target string, weight float64) (int, error) {
+	l.lock.Lock()
+	defer l.unlockAndNotify(&l.lock)
+
+	id := l.nextID
+	if err := l.addEdge(Edge{ID: id, Source: source, Target: target, Weight: weight}); err != nil {
+		return 0, err
 	}
 
+	return id, nil
+}
+
+// restoreEdge adds the previously deleted edge with its original ID.
+func (l *adjList) restoreEdge(edge Edge) error {
 	l.lock.Lock()
-	defer l.lock.Unlock()
+	defer l.unlockAndNotify(&l.lock)
 
-	i, ok := l.vertices[source]
+	if _, ok := l.edges[edge.ID]; ok {
+		return ErrEdgeAlreadyExists(edge.Source, edge.Target)
+	}
+
+	return l.addEdge(edge)
+}
+
+func (l *adjList) addEdge(edge Edge) error {
+	i, ok := l.vertices[edge.Source]
+	if !ok {
+		return ErrVertexNotFound(edge.Source)
+	}
+
+	j, ok := l.vertices[edge.Target]
 	if !ok {
+		return ErrVertexNotFound(edge.Target)
+	}
+
+	if err := l.policy.checkEdge(edge.Source, edge.Target, l.hasEdge(edge.Source, edge.Target)); err != nil {
+		return err
+	}
+
+	if edge.ID >= l.nextID {
+		l.nextID = edge.ID + 1
+	}
+
+	l.lists[i].PushBack(listNode{name: edge.Target, id: edge.ID, weight: edge.Weight})
+
+	// A self-loop is stored once
+	if l.undirected && i != j {
+		l.lists[j].PushBack(listNode{name: edge.Source, id: edge.ID, weight: edge.Weight})
+	} else if !l.undirected {
+		l.in[j].PushBack(listNode{name: edge.Source, id: edge.ID, weight: edge.Weight})
+	}
+
+	l.edges[edge.ID] = edge
+
+	l.record(Event{Type: EdgeAdded, Edge: edge})
+
+	return nil
+}
+
+// DeleteEdge removes all edges between source and target.
+//
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(1)
+func (l *adjList) DeleteEdge(source, target string) error {
+	l.lock.Lock()
+	defer l.unlockAndNotify(&l.lock)
+
+	if _, ok := l.vertices[source]; !ok {
 		return ErrVertexNotFound(source)
 	}
 
-	l.lists[i].PushBack(target)
+	if _, ok := l.vertices[target]; !ok {
+		return ErrVertexNotFound(target)
+	}
 
-	if l.undirected {
-		j, ok := l.vertices[target]
-		if !ok {
-			return ErrVertexNotFound(target)
+	deleted := make(map[int]Edge)
+	for id, edge := range l.edges {
+		if edge.connects(source, target, l.undirected) {
+			deleted[id] = edge
 		}
+	}
 
-		l.lists[j].PushBack(source)
+	if len(deleted) == 0 {
+		return ErrEdgeNotFound(source, target)
 	}
 
-	l.e++
+	for id := range deleted {
+		l.deleteEdge(id)
+	}
+	l.recordDeletedEdges(deleted)
 
 	return nil
 }
 
+// DeleteEdgeByID removes the edge with the given ID.
+//
 // Time complexity: O(n), where n is number of vertices
 //
 // Space complexity: O(1)
-func (l *adjList) DeleteEdge(source, target string) error {
+func (l *adjList) DeleteEdgeByID(id int) error {
 	l.lock.Lock()
-	defer l.lock.Unlock()
+	defer l.unlockAndNotify(&l.lock)
 
-	i, ok := l.vertices[source]
+	edge, ok := l.edges[id]
 	if !ok {
-		return ErrVertexNotFound(source)
+		return ErrEdgeIDNotFound(id)
 	}
 
-	// Remove source -> target edge from source's list
-	for e := l.lists[i].Front(); e != nil; e = e.Next() {
-		if e.Value == target {
-			l.lists[i].Remove(e)
+	l.deleteEdge(edge.ID)
+	l.record(Event{Type: EdgeDeleted, Edge: edge})
+
+	return nil
+}
+
+func (l *adjList) deleteEdge(id int) {
+	edge := l.edges[id]
+
+	for _, vertex := range [2]string{edge.Source, edge.Target} {
+		i := l.vertices[vertex]
+		for _, list := range [2]*list.List{l.lists[i], l.in[i]} {
+			removeListNodes(list, func(node listNode) bool {
+				return node.id == id
+			})
 		}
 	}
 
-	if l.undirected {
-		j, ok := l.vertices[target]
-		if !ok {
-			return ErrVertexNotFound(target)
-		}
+	delete(l.edges, id)
+}
 
-		// Remove target -> sourcr edge from target's list
-		for e := l.lists[j].Front(); e != nil; e = e.Next() {
-			if e.Value == target {
-				l.lists[j].Remove(e)
-			}
+// ListEdges returns all edges ordered by ID.
+//
+// Time complexity: O(m*log(m)), where m is number of edges
+//
+// Space complexity: O(m), where m is number of edges
+func (l *adjList) ListEdges() []Edge {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return sortedEdges(l.edges)
+}
+
+// clone returns a deep copy of the representation without listeners.
+//
+// Time complexity: O(n+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (l *adjList) clone() GraphRepr {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	c := &adjList{
+		v:          l.v,
+		undirected: l.undirected,
+		policy:     l.policy,
+		nextID:     l.nextID,
+		vertices:   make(map[string]int, len(l.vertices)),
+		lists:      make([]*list.List, len(l.lists)),
+		in:         make([]*list.List, len(l.in)),
+		edges:      make(map[int]Edge, len(l.edges)),
+	}
+
+	for vertex, idx := range l.vertices {
+		c.vertices[vertex] = idx
+	}
+	for i, nodes := range l.lists {
+		c.lists[i] = list.New()
+		c.lists[i].PushBackList(nodes)
+		c.in[i] = list.New()
+		c.in[i].PushBackList(l.in[i])
+	}
+	for id, edge := range l.edges {
+		c.edges[id] = edge
+	}
+	c.weighted = l.weighted
+
+	return c
+}
+
+// index builds the adjIndex of the graph under a single read lock,
+// so the algorithms see a consistent view of the graph, the neighbors are indexed by the given number of workers.
+//
+// Time complexity: O(n*log(n)+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (l *adjList) index(workers int) (*adjIndex, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.buildIndex(workers)
+}
+
+// indexWithEdges builds the adjIndex and lists the edges ordered by ID under a single read lock,
+// so the weights of the edges match the indexed vertices.
+//
+// Time complexity: O(n*log(n)+m*log(m)), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (l *adjList) indexWithEdges(workers int) (*adjIndex, []Edge, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	idx, err := l.buildIndex(workers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return idx, sortedEdges(l.edges), nil
+}
+
+func (l *adjList) buildIndex(workers int) (*adjIndex, error) {
+	names := make([]string, 0, l.v)
+	for _, vIdx := range l.vertexIdx() {
+		names = append(names, vIdx.Vertex)
+	}
+
+	return indexVertices(!l.undirected, names, func(vertex string) ([]string, error) {
+		adjacent := l.lists[l.vertices[vertex]]
+		neighbors := make([]string, 0, adjacent.Len())
+		for e := adjacent.Front(); e != nil; e = e.Next() {
+			neighbors = append(neighbors, e.Value.(listNode).name)
 		}
+		return neighbors, nil
+	}, workers)
+}
+
+// arcs returns the neighbors of the vertex with the lightest weight of the edges to them,
+// the edges are followed backwards if reverse is set.
+//
+// Time complexity: O(d), where d is degree of the vertex
+//
+// Space complexity: O(d), where d is degree of the vertex
+func (l *adjList) arcs(vertex string, reverse bool) ([]arc, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	i, ok := l.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
 	}
 
-	l.e--
+	nodes := l.lists[i]
+	if reverse && !l.undirected {
+		nodes = l.in[i]
+	}
 
-	return nil
+	arcs := make([]arc, 0, nodes.Len())
+	seen := make(map[string]int, nodes.Len())
+	for e := nodes.Front(); e != nil; e = e.Next() {
+		node := e.Value.(listNode)
+		arcs = appendArc(arcs, seen, node.name, node.weight)
+	}
+
+	return arcs, nil
+}
+
+// unweighted tells whether all edges have DefaultWeight.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (l *adjList) unweighted() bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.weighted == 0
+}
+
+// snapshot returns the persistent copy of the graph shared by its snapshots.
+//
+// Time complexity: O(1), the first call is O((n+m)*log(n+m)), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(1), the first call is O(n+m), where n is number of vertices, m is number of edges
+func (l *adjList) snapshot() frozenGraph {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.notifier.snapshot(func() *frozenGraph {
+		vertices := make([]string, 0, l.v)
+		for _, vIdx := range l.vertexIdx() {
+			vertices = append(vertices, vIdx.Vertex)
+		}
+		return freeze(!l.undirected, vertices, sortedEdges(l.edges))
+	})
+}
+
+// removeListNodes removes the nodes matching the predicate from the list.
+func removeListNodes(list *list.List, match func(node listNode) bool) {
+	for e := list.Front(); e != nil; {
+		next := e.Next()
+		if match(e.Value.(listNode)) {
+			list.Remove(e)
+		}
+		e = next
+	}
 }
 
 // Time complexity: O(v+e), where v is number of vertices, and e is number of edges
@@ -297,7 +608,7 @@ func (l *adjList) BFS(start string, callback func(vertex string)) error {
 		}
 
 		for e := l.lists[currIdx].Front(); e != nil; e = e.Next() {
-			vertex := e.Value.(string)
+			vertex := e.Value.(listNode).name
 
 			if !visited[vertex] {
 				visited[vertex] = true
@@ -330,7 +641,7 @@ func (l *adjList) dfs(vertex string, callback func(vertex string), visited map[s
 	}
 
 	for e := l.lists[i].Front(); e != nil; e = e.Next() {
-		vertex := e.Value.(string)
+		vertex := e.Value.(listNode).name
 
 		if !visited[vertex] {
 			if err := l.dfs(vertex, callback, visited); err != nil {
@@ -350,11 +661,11 @@ func (l *adjList) IsCyclic() bool {
 	defer l.lock.RUnlock()
 
 	if l.undirected {
-		panic(ErrCyclicCheckOnlyForDirected)
+		return hasUndirectedCycle(l.edges)
 	}
 
-	visited := make(map[string]bool, l.Vertices())
-	recMap := make(map[string]bool, l.Vertices())
+	visited := make(map[string]bool, l.v)
+	recMap := make(map[string]bool, l.v)
 
 	for vertex := range l.vertices {
 		if !visited[vertex] && l.isCyclicRec(vertex, visited, recMap) {
@@ -379,7 +690,7 @@ func (l *adjList) isCyclicRec(vertex string, visited, recMap map[string]bool) bo
 		// }
 
 		for e := l.lists[i].Front(); e != nil; e = e.Next() {
-			v := e.Value.(string)
+			v := e.Value.(listNode).name
 			if !visited[v] && l.isCyclicRec(v, visited, recMap) {
 				return true
 			} else if vis, ok := recMap[v]; ok && vis {
@@ -439,9 +750,9 @@ func (l *adjList) String() string {
 		buffer.WriteString("[")
 		for e := list.Front(); e != nil; e = e.Next() {
 			if e == list.Front() {
-				buffer.WriteString(fmt.Sprintf("%v", e.Value))
+				buffer.WriteString(fmt.Sprintf("%v", e.Value.(listNode).name))
 			} else {
-				buffer.WriteString(fmt.Sprintf(", %v", e.Value))
+				buffer.WriteString(fmt.Sprintf(", %v", e.Value.(listNode).name))
 			}
 		}
 		if list.Len() == 0 {
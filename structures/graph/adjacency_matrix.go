@@ -11,25 +11,29 @@ import (
 
 // Space complexity: O(n^2), where n is number of vertices
 type adjMatrix struct {
+	notifier
 	lock         sync.RWMutex
 	v            int
-	e            int
 	undirected   bool
+	policy       edgePolicy
+	nextID       int
 	vertices     map[string]int
 	verticeNames map[int]string
-	matrix       [][]int8
+	// matrix[i][j] is 1 if there is at least one edge i -> j
+	matrix [][]int8
+	// weights[i][j] is the lightest weight of the edges i -> j
+	weights [][]float64
+	edges   map[int]Edge
 }
 
-func newAdjMatrix(opts ...GraphOption) *adjMatrix {
+func newAdjMatrix() *adjMatrix {
 	matrix := &adjMatrix{
 		undirected:   true,
 		vertices:     make(map[string]int),
 		verticeNames: make(map[int]string),
 		matrix:       make([][]int8, 0),
-	}
-
-	for _, opt := range opts {
-		opt(matrix)
+		weights:      make([][]float64, 0),
+		edges:        make(map[int]Edge),
 	}
 
 	return matrix
@@ -42,6 +46,20 @@ func (m *adjMatrix) setDirected() {
 	m.undirected = false
 }
 
+func (m *adjMatrix) setEdgePolicy(policy edgePolicy) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.policy = policy
+}
+
+func (m *adjMatrix) edgePolicy() edgePolicy {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.policy
+}
+
 func (m *adjMatrix) IsDirected() bool {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -60,7 +78,7 @@ func (m *adjMatrix) Edges() int {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	return m.e
+	return len(m.edges)
 }
 
 // Time complexity: O(1)
@@ -74,12 +92,53 @@ func (m *adjMatrix) HasVertex(vertex string) bool {
 	return has
 }
 
+// ListVertices returns the vertices in the order they were added.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (m *adjMatrix) ListVertices() []string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	vertices := make([]string, 0, m.v)
+	for i := 0; i < m.v; i++ {
+		vertices = append(vertices, m.verticeNames[i])
+	}
+
+	return vertices
+}
+
+// Neighbors returns the distinct vertices adjacent to the given vertex.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (m *adjMatrix) Neighbors(vertex string) ([]string, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	i, ok := m.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	neighbors := make([]string, 0)
+	for j := range m.matrix[i] {
+		if m.matrix[i][j] == 1 {
+			neighbors = append(neighbors, m.verticeNames[j])
+		}
+	}
+
+	return neighbors, nil
+}
+
 // Time complexity: O(n), where n is number of vertices
 //
 // Space complexity: O(1)
 func (m *adjMatrix) AddVertex(vertex string) error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
+	defer m.unlockAndNotify(&m.lock)
 
 	if _, ok := m.vertices[vertex]; ok {
 		return ErrVertexAlreadyExists(vertex)
@@ -92,38 +151,54 @@ func (m *adjMatrix) AddVertex(vertex string) error {
 	// Add to each row new column
 	for i := range m.matrix {
 		m.matrix[i] = append(m.matrix[i], 0)
+		m.weights[i] = append(m.weights[i], 0)
 	}
 	// Add new row
 	m.matrix = append(m.matrix, make([]int8, nextIdx+1))
+	m.weights = append(m.weights, make([]float64, nextIdx+1))
 
 	m.v++
 
+	m.record(Event{Type: VertexAdded, Vertex: vertex})
+
 	return nil
 }
 
-// Time complexity: O(n^2), where n is number of vertices
+// Time complexity: O(n^2+m), where n is number of vertices, m is number of edges
 //
 // Space complexity: O(n), where n is number of vertices
 func (m *adjMatrix) DeleteVertex(vertex string) error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
+	defer m.unlockAndNotify(&m.lock)
 
 	vertexIdx, ok := m.vertices[vertex]
 	if !ok {
 		return ErrVertexNotFound(vertex)
 	}
 
+	// Delete all edges associated with that vertex
+	deleted := make(map[int]Edge)
+	for id, edge := range m.edges {
+		if edge.Source == vertex || edge.Target == vertex {
+			deleted[id] = edge
+			delete(m.edges, id)
+		}
+	}
+	m.recordDeletedEdges(deleted)
+
 	// Delete row
 	m.matrix = append(m.matrix[:vertexIdx], m.matrix[vertexIdx+1:]...)
+	m.weights = append(m.weights[:vertexIdx], m.weights[vertexIdx+1:]...)
 	// Delete column
 	for i := range m.matrix {
 		m.matrix[i] = append(m.matrix[i][:vertexIdx], m.matrix[i][vertexIdx+1:]...)
+		m.weights[i] = append(m.weights[i][:vertexIdx], m.weights[i][vertexIdx+1:]...)
 	}
 
 	// Delete from vertices map
 	delete(m.vertices, vertex)
-	// Delete from verticeNames map
-	delete(m.verticeNames, vertexIdx)
+	// Delete last index from verticeNames map, the rest of indexes is shifted below
+	delete(m.verticeNames, m.v-1)
 	for k, idx := range m.vertices {
 		if idx > vertexIdx {
 			idx -= 1
@@ -136,6 +211,8 @@ func (m *adjMatrix) DeleteVertex(vertex string) error {
 
 	m.v--
 
+	m.record(Event{Type: VertexDeleted, Vertex: vertex})
+
 	return nil
 }
 
@@ -146,6 +223,10 @@ func (m *adjMatrix) HasEdge(source, target string) bool {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
+	return m.hasEdge(source, target)
+}
+
+func (m *adjMatrix) hasEdge(source, target string) bool {
 	i, ok := m.vertices[source]
 	if !ok {
 		return false
@@ -156,51 +237,98 @@ func (m *adjMatrix) HasEdge(source, target string) bool {
 		return false
 	}
 
-	if m.undirected {
-		return m.matrix[i][j] == 1 && m.matrix[j][i] == 1
-	} else {
-		return m.matrix[i][j] == 1
-	}
+	return m.matrix[i][j] == 1
 }
 
 // Time complexity: O(1)
 //
 // Space complexity: O(1)
 func (m *adjMatrix) AddEdge(source, target string) error {
-	if ok := m.HasEdge(source, target); ok {
-		return ErrEdgeAlreadyExists(source, target)
+	_, err := m.AddEdgeWithID(source, target)
+	return err
+}
+
+// AddEdgeWithID adds the edge with DefaultWeight and returns its ID.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (m *adjMatrix) AddEdgeWithID(source, target string) (int, error) {
+	return m.AddWeightedEdge(source, target, DefaultWeight)
+}
+
+// AddWeightedEdge adds the edge with the given weight and returns its ID.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (m *adjMatrix) AddWeightedEdge(source, target string, weight float64) (int, error) {
+	m.lock.Lock()
+	defer m.unlockAndNotify(&m.lock)
+
+	id := m.nextID
+	if err := m.addEdge(Edge{ID: id, Source: source, Target: target, Weight: weight}); err != nil {
+		return 0, err
 	}
 
+	return id, nil
+}
+
+// restoreEdge adds the previously deleted edge with its original ID.
+func (m *adjMatrix) restoreEdge(edge Edge) error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
+	defer m.unlockAndNotify(&m.lock)
 
-	i, ok := m.vertices[source]
+	if _, ok := m.edges[edge.ID]; ok {
+		return ErrEdgeAlreadyExists(edge.Source, edge.Target)
+	}
+
+	return m.addEdge(edge)
+}
+
+func (m *adjMatrix) addEdge(edge Edge) error {
+	i, ok := m.vertices[edge.Source]
 	if !ok {
-		return ErrVertexNotFound(source)
+		return ErrVertexNotFound(edge.Source)
 	}
 
-	j, ok := m.vertices[target]
+	j, ok := m.vertices[edge.Target]
 	if !ok {
-		return ErrVertexNotFound(target)
+		return ErrVertexNotFound(edge.Target)
+	}
+
+	if err := m.policy.checkEdge(edge.Source, edge.Target, m.matrix[i][j] == 1); err != nil {
+		return err
 	}
 
+	if m.matrix[i][j] == 0 || edge.Weight < m.weights[i][j] {
+		m.weights[i][j] = edge.Weight
+	}
 	m.matrix[i][j] = 1
 
 	if m.undirected {
 		m.matrix[j][i] = 1
+		m.weights[j][i] = m.weights[i][j]
+	}
+
+	if edge.ID >= m.nextID {
+		m.nextID = edge.ID + 1
 	}
+	m.edges[edge.ID] = edge
 
-	m.e++
+	m.record(Event{Type: EdgeAdded, Edge: edge})
 
 	return nil
 }
 
-// Time complexity: O(1)
+// DeleteEdge removes all edges between source and target.
+//
+// Time complexity: O(m), where m is number of edges
 //
 // Space complexity: O(1)
 func (m *adjMatrix) DeleteEdge(source, target string) error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
+	defer m.unlockAndNotify(&m.lock)
 
 	i, ok := m.vertices[source]
 	if !ok {
@@ -212,21 +340,223 @@ func (m *adjMatrix) DeleteEdge(source, target string) error {
 		return ErrVertexNotFound(target)
 	}
 
-	if m.matrix[i][j] == 0 && m.matrix[j][i] == 0 {
+	if m.matrix[i][j] == 0 {
 		return ErrEdgeNotFound(source, target)
 	}
 
+	deleted := make(map[int]Edge)
+	for id, edge := range m.edges {
+		if edge.connects(source, target, m.undirected) {
+			deleted[id] = edge
+			delete(m.edges, id)
+		}
+	}
+	m.recordDeletedEdges(deleted)
+
 	m.matrix[i][j] = 0
 
 	if m.undirected {
 		m.matrix[j][i] = 0
 	}
 
-	m.e--
+	return nil
+}
+
+// DeleteEdgeByID removes the edge with the given ID.
+//
+// Time complexity: O(m), where m is number of edges
+//
+// Space complexity: O(1)
+func (m *adjMatrix) DeleteEdgeByID(id int) error {
+	m.lock.Lock()
+	defer m.unlockAndNotify(&m.lock)
+
+	edge, ok := m.edges[id]
+	if !ok {
+		return ErrEdgeIDNotFound(id)
+	}
+
+	delete(m.edges, id)
+	m.record(Event{Type: EdgeDeleted, Edge: edge})
+
+	// Keep the cell while there are parallel edges left
+	i, j := m.vertices[edge.Source], m.vertices[edge.Target]
+	m.matrix[i][j] = 0
+	for _, other := range m.edges {
+		if other.connects(edge.Source, edge.Target, m.undirected) {
+			if m.matrix[i][j] == 0 || other.Weight < m.weights[i][j] {
+				m.weights[i][j] = other.Weight
+			}
+			m.matrix[i][j] = 1
+		}
+	}
+
+	if m.undirected {
+		m.matrix[j][i] = m.matrix[i][j]
+		m.weights[j][i] = m.weights[i][j]
+	}
 
 	return nil
 }
 
+// ListEdges returns all edges ordered by ID.
+//
+// Time complexity: O(m*log(m)), where m is number of edges
+//
+// Space complexity: O(m), where m is number of edges
+func (m *adjMatrix) ListEdges() []Edge {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return sortedEdges(m.edges)
+}
+
+// clone returns a deep copy of the representation without listeners.
+//
+// Time complexity: O(n^2+m), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n^2+m), where n is number of vertices, m is number of edges
+func (m *adjMatrix) clone() GraphRepr {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	c := &adjMatrix{
+		v:            m.v,
+		undirected:   m.undirected,
+		policy:       m.policy,
+		nextID:       m.nextID,
+		vertices:     make(map[string]int, len(m.vertices)),
+		verticeNames: make(map[int]string, len(m.verticeNames)),
+		matrix:       make([][]int8, len(m.matrix)),
+		weights:      make([][]float64, len(m.weights)),
+		edges:        make(map[int]Edge, len(m.edges)),
+	}
+
+	for vertex, idx := range m.vertices {
+		c.vertices[vertex] = idx
+	}
+	for idx, vertex := range m.verticeNames {
+		c.verticeNames[idx] = vertex
+	}
+	for i, row := range m.matrix {
+		c.matrix[i] = append([]int8(nil), row...)
+		c.weights[i] = append([]float64(nil), m.weights[i]...)
+	}
+	for id, edge := range m.edges {
+		c.edges[id] = edge
+	}
+	c.weighted = m.weighted
+
+	return c
+}
+
+// index builds the adjIndex of the graph under a single read lock,
+// so the algorithms see a consistent view of the graph, the neighbors are indexed by the given number of workers.
+//
+// Time complexity: O(n^2), where n is number of vertices
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (m *adjMatrix) index(workers int) (*adjIndex, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.buildIndex(workers)
+}
+
+// indexWithEdges builds the adjIndex and lists the edges ordered by ID under a single read lock,
+// so the weights of the edges match the indexed vertices.
+//
+// Time complexity: O(n^2+m*log(m)), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(n+m), where n is number of vertices, m is number of edges
+func (m *adjMatrix) indexWithEdges(workers int) (*adjIndex, []Edge, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	idx, err := m.buildIndex(workers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return idx, sortedEdges(m.edges), nil
+}
+
+func (m *adjMatrix) buildIndex(workers int) (*adjIndex, error) {
+	names := make([]string, m.v)
+	for i := range names {
+		names[i] = m.verticeNames[i]
+	}
+
+	return indexVertices(!m.undirected, names, func(vertex string) ([]string, error) {
+		i := m.vertices[vertex]
+		neighbors := make([]string, 0)
+		for j := 0; j < m.v; j++ {
+			if m.matrix[i][j] == 1 {
+				neighbors = append(neighbors, m.verticeNames[j])
+			}
+		}
+		return neighbors, nil
+	}, workers)
+}
+
+// arcs returns the neighbors of the vertex with the lightest weight of the edges to them,
+// the edges are followed backwards if reverse is set.
+//
+// Time complexity: O(n), where n is number of vertices
+//
+// Space complexity: O(n), where n is number of vertices
+func (m *adjMatrix) arcs(vertex string, reverse bool) ([]arc, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	i, ok := m.vertices[vertex]
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	arcs := make([]arc, 0)
+	for j := 0; j < m.v; j++ {
+		u, v := i, j
+		if reverse {
+			u, v = j, i
+		}
+		if m.matrix[u][v] == 1 {
+			arcs = append(arcs, arc{vertex: m.verticeNames[j], weight: m.weights[u][v]})
+		}
+	}
+
+	return arcs, nil
+}
+
+// unweighted tells whether all edges have DefaultWeight.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (m *adjMatrix) unweighted() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.weighted == 0
+}
+
+// snapshot returns the persistent copy of the graph shared by its snapshots.
+//
+// Time complexity: O(1), the first call is O(n+m*log(n+m)), where n is number of vertices, m is number of edges
+//
+// Space complexity: O(1), the first call is O(n+m), where n is number of vertices, m is number of edges
+func (m *adjMatrix) snapshot() frozenGraph {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.notifier.snapshot(func() *frozenGraph {
+		vertices := make([]string, 0, m.v)
+		for i := 0; i < m.v; i++ {
+			vertices = append(vertices, m.verticeNames[i])
+		}
+		return freeze(!m.undirected, vertices, sortedEdges(m.edges))
+	})
+}
+
 // Time complexity: O(n^2), where n is number of vertices
 //
 // Space complexity: O(n), where n is number of vertices
@@ -310,11 +640,11 @@ func (m *adjMatrix) IsCyclic() bool {
 	defer m.lock.RUnlock()
 
 	if m.undirected {
-		panic(ErrCyclicCheckOnlyForDirected)
+		return hasUndirectedCycle(m.edges)
 	}
 
-	visited := make([]bool, m.Vertices())
-	recStack := make([]bool, m.Vertices())
+	visited := make([]bool, m.v)
+	recStack := make([]bool, m.v)
 
 	for i := range m.matrix {
 		if !visited[i] && m.isCyclicRec(i, visited, recStack) {
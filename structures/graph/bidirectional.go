@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/dkhrunov/dsa-go/structures/heap"
+	"github.com/dkhrunov/dsa-go/structures/queue"
+	"github.com/dkhrunov/dsa-go/utils"
+)
+
+// searchItem is an item of the priority queue of a side of the bidirectional search.
+type searchItem struct {
+	dist   float64
+	vertex string
+}
+
+// searchSide is one of the two searches of a bidirectional search:
+// the forward one follows the edges, the backward one follows them reversed.
+//
+// Only the reached vertices are kept, so a search touches the part of the graph around its ends.
+// The Dijkstra search keeps the reached vertices in the priority queue, the BFS keeps them in the FIFO queue.
+type searchSide struct {
+	gr      GraphRepr
+	reverse bool
+	dist    map[string]float64
+	prev    map[string]string
+	queue   *heap.Heap[searchItem]
+	level   *queue.Queue
+}
+
+func newSearchSide(gr GraphRepr, reverse bool, start string) *searchSide {
+	side := &searchSide{
+		gr:      gr,
+		reverse: reverse,
+		dist:    map[string]float64{start: 0},
+		prev:    make(map[string]string),
+		queue: heap.NewWithComparator(func(a, b searchItem) int8 {
+			return utils.LessComparator(a.dist, b.dist)
+		}),
+	}
+	side.queue.Insert(searchItem{dist: 0, vertex: start})
+	return side
+}
+
+func newBFSSide(gr GraphRepr, reverse bool, start string) *searchSide {
+	side := &searchSide{
+		gr:      gr,
+		reverse: reverse,
+		dist:    map[string]float64{start: 0},
+		prev:    make(map[string]string),
+		level:   queue.New(),
+	}
+	side.level.EnQueue(start)
+	return side
+}
+
+// distance returns the tentative distance to the vertex, +Inf if it isn't reached yet.
+func (side *searchSide) distance(vertex string) float64 {
+	if d, ok := side.dist[vertex]; ok {
+		return d
+	}
+	return math.Inf(1)
+}
+
+// top drops the outdated items of the queue and returns the smallest distance in it, +Inf if it is empty.
+func (side *searchSide) top() float64 {
+	for {
+		item, ok := side.queue.Peek()
+		if !ok {
+			return math.Inf(1)
+		}
+		if item.dist <= side.dist[item.vertex] {
+			return item.dist
+		}
+		side.queue.Pop()
+	}
+}
+
+// path returns the vertices from the start of the side to the vertex.
+func (side *searchSide) path(vertex string) []string {
+	path := []string{vertex}
+	for v, ok := side.prev[vertex]; ok; v, ok = side.prev[v] {
+		path = append(path, v)
+	}
+	return path
+}
+
+// ShortestPathBidirectional returns the path of the smallest total weight from source to target
+// searching simultaneously from both ends, which explores far fewer vertices than a search from the source only.
+// Directed graphs are searched backwards from the target over the edges entering the vertices.
+//
+// If all edges of the graph have DefaultWeight, the search is a bidirectional BFS over FIFO queues,
+// otherwise it is a bidirectional Dijkstra over priority queues, of parallel edges the lightest one is used.
+// Vertices are read from the graph as the search reaches them, so the cost of a query
+// depends on the explored part of the graph only and not on the size of the graph.
+//
+// Returns ErrPathNotFound if target isn't reachable from source
+// and ErrNegativeWeight if the search meets an edge with negative weight.
+//
+// Time complexity: O(k) for unweighted graphs and O(k*log(k)) for weighted graphs,
+// where k is number of edges of the explored vertices,
+// O((v+e)*log(v)) in the worst case, where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(k), where k is number of edges of the explored vertices
+func (g *Graph) ShortestPathBidirectional(source, target string) (Path, error) {
+	if !g.repr.HasVertex(source) {
+		return Path{}, ErrVertexNotFound(source)
+	}
+	if !g.repr.HasVertex(target) {
+		return Path{}, ErrVertexNotFound(target)
+	}
+
+	if source == target {
+		return Path{Vertices: []string{source}, Cost: 0}, nil
+	}
+
+	var (
+		forward, backward *searchSide
+		meet              string
+		cost              float64
+		err               error
+	)
+	if g.repr.unweighted() {
+		forward, backward = newBFSSide(g.repr, false, source), newBFSSide(g.repr, true, target)
+		meet, cost, err = bidirectionalBFS(forward, backward)
+	} else {
+		forward, backward = newSearchSide(g.repr, false, source), newSearchSide(g.repr, true, target)
+		meet, cost, err = bidirectionalDijkstra(forward, backward)
+	}
+	if err != nil {
+		return Path{}, err
+	}
+
+	if math.IsInf(cost, 1) {
+		return Path{}, ErrPathNotFound(source, target)
+	}
+
+	path := forward.path(meet)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	path = append(path, backward.path(meet)[1:]...)
+
+	return Path{Vertices: path, Cost: cost}, nil
+}
+
+// bidirectionalBFS expands a whole level of the side with the smaller queue at a time
+// and stops after the level where the searches meet, all edges are assumed to have DefaultWeight.
+// Returns the vertex where the searches meet on a shortest path and the length of the path, +Inf if none.
+func bidirectionalBFS(forward, backward *searchSide) (string, float64, error) {
+	sides := [2]*searchSide{forward, backward}
+
+	meet, best := "", math.Inf(1)
+	for sides[0].level.Len() > 0 && sides[1].level.Len() > 0 {
+		i := 0
+		if sides[1].level.Len() < sides[0].level.Len() {
+			i = 1
+		}
+		side, other := sides[i], sides[1-i]
+
+		for n := side.level.Len(); n > 0; n-- {
+			u := side.level.DeQueue().(string)
+			arcs, err := side.gr.arcs(u, side.reverse)
+			if err != nil {
+				return "", 0, err
+			}
+
+			for _, a := range arcs {
+				if _, ok := side.dist[a.vertex]; !ok {
+					side.dist[a.vertex] = side.dist[u] + DefaultWeight
+					side.prev[a.vertex] = u
+					side.level.EnQueue(a.vertex)
+				}
+				if total := side.distance(a.vertex) + other.distance(a.vertex); total < best {
+					meet, best = a.vertex, total
+				}
+			}
+		}
+
+		if !math.IsInf(best, 1) {
+			return meet, best, nil
+		}
+	}
+
+	return meet, best, nil
+}
+
+// bidirectionalDijkstra settles a vertex of the side with the smaller tentative distance at a time
+// and stops when the sum of the smallest distances of both sides can't improve the best path found so far.
+// Returns the vertex where the searches meet on a shortest path and the length of the path, +Inf if none.
+func bidirectionalDijkstra(forward, backward *searchSide) (string, float64, error) {
+	sides := [2]*searchSide{forward, backward}
+
+	meet, best := "", math.Inf(1)
+	for {
+		tops := [2]float64{sides[0].top(), sides[1].top()}
+		if math.IsInf(tops[0], 1) || math.IsInf(tops[1], 1) || tops[0]+tops[1] >= best {
+			return meet, best, nil
+		}
+
+		i := 0
+		if tops[1] < tops[0] {
+			i = 1
+		}
+		side, other := sides[i], sides[1-i]
+
+		item, _ := side.queue.Pop()
+		u := item.vertex
+		arcs, err := side.gr.arcs(u, side.reverse)
+		if err != nil {
+			return "", 0, err
+		}
+
+		for _, a := range arcs {
+			if a.weight < 0 {
+				return "", 0, ErrNegativeWeight
+			}
+
+			if alt := side.dist[u] + a.weight; alt < side.distance(a.vertex) {
+				side.dist[a.vertex] = alt
+				side.prev[a.vertex] = u
+				side.queue.Insert(searchItem{dist: alt, vertex: a.vertex})
+			}
+			if total := side.distance(a.vertex) + other.distance(a.vertex); total < best {
+				meet, best = a.vertex, total
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requirePath checks that the path follows the edges of the graph from source to target.
+func requirePath(t *testing.T, g *Graph, path Path, source, target string) {
+	t.Helper()
+	require.Equal(t, source, path.Vertices[0])
+	require.Equal(t, target, path.Vertices[len(path.Vertices)-1])
+	requireWalk(t, g, path.Vertices)
+}
+
+func TestGraphShortestPathBidirectional(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			vertices := []string{"A", "B", "C", "D", "E", "F"}
+			edges := [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "E"}, {"E", "D"}}
+
+			t.Run("undirected", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t, WithVertices(vertices), WithEdges(edges))
+
+				path, err := g.ShortestPathBidirectional("A", "D")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"A", "E", "D"}, Cost: 2}, path)
+
+				path, err = g.ShortestPathBidirectional("D", "A")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"D", "E", "A"}, Cost: 2}, path)
+
+				path, err = g.ShortestPathBidirectional("A", "A")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"A"}, Cost: 0}, path)
+
+				_, err = g.ShortestPathBidirectional("A", "F")
+				require.EqualError(t, err, ErrPathNotFound("A", "F").Error())
+
+				_, err = g.ShortestPathBidirectional("A", "Z")
+				require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+				// Weights make the longer path cheaper.
+				_, err = g.AddWeightedEdge("A", "C", 0.5)
+				require.NoError(t, err)
+				path, err = g.ShortestPathBidirectional("A", "D")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"A", "C", "D"}, Cost: 1.5}, path)
+			})
+
+			t.Run("directed", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newDirected(t, WithVertices(vertices), WithEdges(edges))
+
+				path, err := g.ShortestPathBidirectional("A", "D")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"A", "E", "D"}, Cost: 2}, path)
+
+				_, err = g.ShortestPathBidirectional("D", "A")
+				require.EqualError(t, err, ErrPathNotFound("D", "A").Error())
+
+				_, err = g.AddWeightedEdge("A", "C", 0.5)
+				require.NoError(t, err)
+				path, err = g.ShortestPathBidirectional("A", "D")
+				require.NoError(t, err)
+				require.Equal(t, Path{Vertices: []string{"A", "C", "D"}, Cost: 1.5}, path)
+			})
+		})
+	}
+}
+
+func TestGraphShortestPathBidirectionalRepresentations(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				AllowParallelEdges(),
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithWeightedEdges([]Edge{
+					{Source: "A", Target: "B", Weight: 5},
+					{Source: "A", Target: "B", Weight: 1},
+					{Source: "B", Target: "D", Weight: 1},
+					{Source: "A", Target: "C", Weight: 3},
+					{Source: "C", Target: "D", Weight: 1},
+					{Source: "D", Target: "A", Weight: 1},
+				}),
+			)
+
+			// The lightest of parallel edges is used.
+			path, err := g.ShortestPathBidirectional("A", "D")
+			require.NoError(t, err)
+			require.Equal(t, Path{Vertices: []string{"A", "B", "D"}, Cost: 2}, path)
+
+			// Deleting the lightest parallel edge leaves the heavier one.
+			require.NoError(t, g.DeleteEdgeByID(1))
+			path, err = g.ShortestPathBidirectional("A", "D")
+			require.NoError(t, err)
+			require.Equal(t, Path{Vertices: []string{"A", "C", "D"}, Cost: 4}, path)
+
+			// The backward search follows the edges entering the vertices.
+			require.NoError(t, g.DeleteVertex("C"))
+			path, err = g.ShortestPathBidirectional("A", "D")
+			require.NoError(t, err)
+			require.Equal(t, Path{Vertices: []string{"A", "B", "D"}, Cost: 6}, path)
+
+			_, err = g.AddWeightedEdge("D", "B", -1)
+			require.NoError(t, err)
+			_, err = g.ShortestPathBidirectional("D", "B")
+			require.ErrorIs(t, err, ErrNegativeWeight)
+		})
+	}
+}
+
+func TestGraphShortestPathBidirectionalRandom(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			seed := int64(5)
+			if tt.directed {
+				seed = 6
+			}
+			unweighted := createRandomGraph(t, tt.newGraph.bind(t), 80, 200, seed)
+
+			rnd := rand.New(rand.NewSource(seed))
+			weighted := tt.newGraph(t, WithVertices(unweighted.ListVertices()))
+			for _, edge := range unweighted.ListEdges() {
+				_, err := weighted.AddWeightedEdge(edge.Source, edge.Target, float64(rnd.Intn(10)))
+				require.NoError(t, err)
+			}
+
+			for _, g := range []*Graph{unweighted, weighted} {
+				for i := 0; i < 30; i++ {
+					source, target := fmt.Sprint(rnd.Intn(80)), fmt.Sprint(rnd.Intn(80))
+
+					want, err := g.KShortestPaths(source, target, 1)
+					require.NoError(t, err)
+
+					got, err := g.ShortestPathBidirectional(source, target)
+					if len(want) == 0 {
+						require.EqualError(t, err, ErrPathNotFound(source, target).Error())
+						continue
+					}
+					require.NoError(t, err)
+					require.Equal(t, want[0].Cost, got.Cost)
+					requirePath(t, g, got, source, target)
+				}
+			}
+		})
+	}
+}
+
+func TestGraphUnweighted(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "graph")
+	g, err := OpenFile(path, WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}}))
+	require.NoError(t, err)
+	require.True(t, g.repr.unweighted())
+
+	id, err := g.AddWeightedEdge("B", "C", 2)
+	require.NoError(t, err)
+	require.False(t, g.repr.unweighted())
+	require.NoError(t, g.Close())
+
+	// The weighted edges are counted when the file is reopened.
+	g, err = OpenFile(path)
+	require.NoError(t, err)
+	defer g.Close()
+	require.False(t, g.repr.unweighted())
+	require.False(t, g.repr.clone().unweighted())
+
+	require.NoError(t, g.DeleteEdgeByID(id))
+	require.True(t, g.repr.unweighted())
+
+	_, err = g.AddWeightedEdge("B", "C", DefaultWeight)
+	require.NoError(t, err)
+	require.True(t, g.repr.unweighted())
+}
@@ -0,0 +1,219 @@
+package graph
+
+import "sort"
+
+// MaximalCliques finds all maximal cliques of an undirected graph using
+// the Bron–Kerbosch algorithm with pivoting and passes each of them to the callback.
+//
+// A clique is passed as the list of its vertices in the order they were added to the graph.
+// Self-loops are ignored.
+//
+// https://en.wikipedia.org/wiki/Bron%E2%80%93Kerbosch_algorithm
+//
+// Time complexity: O(3^(v/3)), where v is number of vertices
+//
+// Space complexity: O(v^2), where v is number of vertices
+func (g *Graph) MaximalCliques(callback func(clique []string)) error {
+	if g.repr.IsDirected() {
+		return ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return err
+	}
+
+	bk := newBronKerbosch(idx)
+	bk.report = func(clique []int) {
+		callback(idx.toNames(clique))
+	}
+	bk.run()
+
+	return nil
+}
+
+// MaximumClique finds the largest clique of an undirected graph,
+// if there are several of them the first found one is returned.
+//
+// Time complexity: O(3^(v/3)), where v is number of vertices
+//
+// Space complexity: O(v^2), where v is number of vertices
+func (g *Graph) MaximumClique() ([]string, error) {
+	if g.repr.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.toNames(maximumClique(idx)), nil
+}
+
+// MaximumIndependentSet finds the largest set of pairwise non-adjacent vertices of an undirected graph,
+// which is the maximum clique of the complement graph.
+//
+// Time complexity: O(3^(v/3)), where v is number of vertices
+//
+// Space complexity: O(v^2), where v is number of vertices
+func (g *Graph) MaximumIndependentSet() ([]string, error) {
+	if g.repr.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.toNames(maximumClique(idx.complement())), nil
+}
+
+func maximumClique(idx *adjIndex) []int {
+	var best []int
+
+	bk := newBronKerbosch(idx)
+	bk.report = func(clique []int) {
+		if len(clique) > len(best) {
+			best = clique
+		}
+	}
+	bk.bound = func() int {
+		return len(best)
+	}
+	bk.run()
+
+	return best
+}
+
+// complement returns the complement of an undirected graph,
+// in which two distinct vertices are adjacent if they are not adjacent in the original graph.
+func (idx *adjIndex) complement() *adjIndex {
+	n := idx.len()
+	comp := &adjIndex{
+		directed: idx.directed,
+		names:    idx.names,
+		index:    idx.index,
+		adj:      make([][]int, n),
+	}
+
+	adjacent := make([]bool, n)
+	for v := 0; v < n; v++ {
+		for _, w := range idx.adj[v] {
+			adjacent[w] = true
+		}
+		for w := 0; w < n; w++ {
+			if w != v && !adjacent[w] {
+				comp.adj[v] = append(comp.adj[v], w)
+			}
+		}
+		for _, w := range idx.adj[v] {
+			adjacent[w] = false
+		}
+	}
+
+	return comp
+}
+
+// bronKerbosch holds the state of the Bron–Kerbosch algorithm.
+type bronKerbosch struct {
+	neighbors []map[int]bool
+	report    func(clique []int)
+	// bound returns the size of the largest clique found so far,
+	// if set, branches which can't produce a larger clique are pruned.
+	bound func() int
+}
+
+func newBronKerbosch(idx *adjIndex) *bronKerbosch {
+	neighbors := make([]map[int]bool, idx.len())
+	for v := range neighbors {
+		neighbors[v] = make(map[int]bool, len(idx.adj[v]))
+		for _, w := range idx.adj[v] {
+			if w != v {
+				neighbors[v][w] = true
+			}
+		}
+	}
+
+	return &bronKerbosch{neighbors: neighbors}
+}
+
+func (bk *bronKerbosch) run() {
+	if len(bk.neighbors) == 0 {
+		return
+	}
+
+	candidates := make([]int, len(bk.neighbors))
+	for v := range candidates {
+		candidates[v] = v
+	}
+
+	bk.expand(nil, candidates, nil)
+}
+
+// expand extends the clique by vertices from candidates,
+// excluded contains vertices which were already processed.
+func (bk *bronKerbosch) expand(clique, candidates, excluded []int) {
+	if len(candidates) == 0 && len(excluded) == 0 {
+		result := append([]int(nil), clique...)
+		sort.Ints(result)
+		bk.report(result)
+		return
+	}
+
+	if bk.bound != nil && len(clique)+len(candidates) <= bk.bound() {
+		return
+	}
+
+	// Choose a pivot with the largest number of neighbors among the candidates,
+	// neighbors of the pivot are not expanded at this level because
+	// every maximal clique must contain the pivot or one of its non-neighbors.
+	pivot, pivotDegree := -1, -1
+	for _, set := range [][]int{candidates, excluded} {
+		for _, u := range set {
+			degree := 0
+			for _, v := range candidates {
+				if bk.neighbors[u][v] {
+					degree++
+				}
+			}
+			if degree > pivotDegree {
+				pivot, pivotDegree = u, degree
+			}
+		}
+	}
+
+	for _, v := range append([]int(nil), candidates...) {
+		if bk.neighbors[pivot][v] {
+			continue
+		}
+
+		bk.expand(append(clique, v), bk.intersect(candidates, v), bk.intersect(excluded, v))
+
+		candidates = without(candidates, v)
+		excluded = append(excluded, v)
+	}
+}
+
+// intersect returns vertices from the set which are adjacent to v.
+func (bk *bronKerbosch) intersect(set []int, v int) []int {
+	result := make([]int, 0, len(set))
+	for _, w := range set {
+		if bk.neighbors[v][w] {
+			result = append(result, w)
+		}
+	}
+	return result
+}
+
+// without returns a copy of the set without v.
+func without(set []int, v int) []int {
+	result := make([]int, 0, len(set))
+	for _, w := range set {
+		if w != v {
+			result = append(result, w)
+		}
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphMaximalCliques(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		vertices []string
+		edges    [][2]string
+		want     [][]string
+	}{
+		{
+			name:     "should find no cliques in empty graph",
+			vertices: nil,
+			want:     nil,
+		},
+		{
+			name:     "should find isolated vertices",
+			vertices: []string{"A", "B"},
+			want:     [][]string{{"A"}, {"B"}},
+		},
+		{
+			name:     "should find all maximal cliques",
+			vertices: []string{"A", "B", "C", "D", "E", "F"},
+			edges: [][2]string{
+				{"A", "B"}, {"A", "C"}, {"B", "C"}, {"B", "D"}, {"C", "D"}, {"D", "E"},
+				{"B", "E"}, {"C", "E"}, {"E", "F"}, {"F", "F"},
+			},
+			want: [][]string{{"A", "B", "C"}, {"B", "C", "D", "E"}, {"E", "F"}},
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newGraph(t, AllowSelfLoops(), WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					var got [][]string
+					err := g.MaximalCliques(func(clique []string) {
+						got = append(got, clique)
+					})
+
+					require.NoError(t, err)
+					require.ElementsMatch(t, tt.want, got)
+				})
+			}
+		})
+	}
+}
+
+func TestGraphMaximumClique(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D", "E", "F"}),
+				WithEdges([][2]string{
+					{"A", "B"}, {"A", "C"}, {"B", "C"}, {"B", "D"}, {"C", "D"}, {"D", "E"},
+					{"B", "E"}, {"C", "E"}, {"E", "F"},
+				}),
+			)
+
+			clique, err := g.MaximumClique()
+			require.NoError(t, err)
+			require.Equal(t, []string{"B", "C", "D", "E"}, clique)
+
+			set, err := g.MaximumIndependentSet()
+			require.NoError(t, err)
+			require.Len(t, set, 3)
+			for i := range set {
+				for j := range set {
+					require.False(t, g.HasEdge(set[i], set[j]))
+				}
+			}
+		})
+	}
+}
+
+func TestGraphMaximumCliqueDirected(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t, WithVertices([]string{"A"}))
+
+			_, err := g.MaximumClique()
+			require.ErrorIs(t, err, ErrNotUndirected)
+
+			_, err = g.MaximumIndependentSet()
+			require.ErrorIs(t, err, ErrNotUndirected)
+
+			err = g.MaximalCliques(func(clique []string) {})
+			require.ErrorIs(t, err, ErrNotUndirected)
+		})
+	}
+}
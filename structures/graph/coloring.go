@@ -0,0 +1,424 @@
+package graph
+
+import (
+	"context"
+	"sort"
+)
+
+// ColoringOrder defines the order in which the greedy strategy colors the vertices.
+//
+// It receives the vertices in the order they were added to the graph and the lookup of their neighbors,
+// and returns the vertices in the coloring order. Unknown vertices are rejected,
+// repeated vertices are skipped and the missing ones are colored last in the order they were added.
+type ColoringOrder func(vertices []string, neighbors func(vertex string) []string) ([]string, error)
+
+// InsertionOrder colors the vertices in the order they were added to the graph.
+//
+// Time complexity: O(v), where v is number of vertices
+//
+// Space complexity: O(v), where v is number of vertices
+func InsertionOrder(vertices []string, neighbors func(vertex string) []string) ([]string, error) {
+	return append([]string(nil), vertices...), nil
+}
+
+// LargestFirstOrder colors the vertices in decreasing order of their degree (Welsh–Powell).
+//
+// Time complexity: O(v*log(v)+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func LargestFirstOrder(vertices []string, neighbors func(vertex string) []string) ([]string, error) {
+	degree := make(map[string]int, len(vertices))
+	for _, vertex := range vertices {
+		degree[vertex] = len(neighbors(vertex))
+	}
+
+	order, _ := InsertionOrder(vertices, neighbors)
+	sort.SliceStable(order, func(i, j int) bool {
+		return degree[order[i]] > degree[order[j]]
+	})
+	return order, nil
+}
+
+// SmallestLastOrder colors the vertices in reverse order of repeatedly removing
+// a vertex of the smallest degree, which uses at most d+1 colors, where d is degeneracy of the graph.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func SmallestLastOrder(vertices []string, neighbors func(vertex string) []string) ([]string, error) {
+	idx, err := indexVertices(false, vertices, func(vertex string) ([]string, error) {
+		return neighbors(vertex), nil
+	}, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	removal, _ := idx.coreDecomposition()
+	order := make([]string, len(removal))
+	for i, v := range removal {
+		order[len(removal)-1-i] = idx.names[v]
+	}
+	return order, nil
+}
+
+// CustomOrder colors the given vertices first in the given order,
+// the rest of the vertices are colored afterwards in the order they were added to the graph.
+func CustomOrder(order ...string) ColoringOrder {
+	return func(vertices []string, neighbors func(vertex string) []string) ([]string, error) {
+		return append(append([]string(nil), order...), vertices...), nil
+	}
+}
+
+// ColoringStrategy defines the algorithm used by Graph.Color,
+// the zero value is the greedy coloring in the insertion order.
+type ColoringStrategy struct {
+	algorithm coloringAlgorithm
+	// order is the order of the greedy coloring.
+	order ColoringOrder
+}
+
+type coloringAlgorithm int
+
+const (
+	greedyColoring coloringAlgorithm = iota
+	dsaturColoring
+	exactColoring
+)
+
+// GreedyColoring assigns to each vertex, in the given order,
+// the smallest color not used by its neighbors.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges (excluding the ordering)
+//
+// Space complexity: O(v), where v is number of vertices
+func GreedyColoring(order ColoringOrder) ColoringStrategy {
+	return ColoringStrategy{algorithm: greedyColoring, order: order}
+}
+
+// DSaturColoring colors the vertex with the largest number of distinct colors among its neighbors
+// (saturation degree) first, ties are broken by the largest number of uncolored neighbors,
+// i.e. the degree in the uncolored part of the graph (Brélaz).
+//
+// Time complexity: O(v^2+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func DSaturColoring() ColoringStrategy {
+	return ColoringStrategy{algorithm: dsaturColoring}
+}
+
+// ExactColoring finds a coloring with the minimum number of colors (chromatic number)
+// by backtracking over the DSATUR ordering, starting from the DSATUR coloring
+// and looking for a coloring with one color less until it is impossible.
+//
+// The search is exponential, so it's bounded by the context: when the context is done
+// the best coloring found so far is returned together with the context error.
+//
+// Time complexity: O(k^v), where v is number of vertices, and k is number of colors
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func ExactColoring() ColoringStrategy {
+	return ColoringStrategy{algorithm: exactColoring}
+}
+
+// color colors the vertices of the index, colors are numbered from 0.
+func (s ColoringStrategy) color(ctx context.Context, idx *adjIndex) ([]int, error) {
+	switch s.algorithm {
+	case dsaturColoring:
+		return idx.dsaturColoring(), nil
+	case exactColoring:
+		return idx.exactColoring(ctx)
+	default:
+		order := s.order
+		if order == nil {
+			order = InsertionOrder
+		}
+		return idx.greedyColoring(order)
+	}
+}
+
+// greedyColoring colors the vertices in the given order by the smallest color not used by their neighbors.
+func (idx *adjIndex) greedyColoring(order ColoringOrder) ([]int, error) {
+	names, err := order(idx.names, func(vertex string) []string {
+		v, ok := idx.index[vertex]
+		if !ok {
+			return nil
+		}
+		return idx.toNames(idx.adj[v])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vertices := make([]int, 0, idx.len())
+	ordered := make([]bool, idx.len())
+	for _, name := range names {
+		v, ok := idx.index[name]
+		if !ok {
+			return nil, ErrVertexNotFound(name)
+		}
+		if !ordered[v] {
+			ordered[v] = true
+			vertices = append(vertices, v)
+		}
+	}
+	for v := range ordered {
+		if !ordered[v] {
+			vertices = append(vertices, v)
+		}
+	}
+
+	colors := uncolored(idx.len())
+	used := make([]int, idx.len()+1)
+	for step, v := range vertices {
+		for _, w := range idx.adj[v] {
+			if colors[w] != -1 {
+				used[colors[w]] = step + 1
+			}
+		}
+
+		color := 0
+		for used[color] == step+1 {
+			color++
+		}
+		colors[v] = color
+	}
+
+	return colors, nil
+}
+
+// dsaturColoring colors the most saturated vertex first.
+func (idx *adjIndex) dsaturColoring() []int {
+	n := idx.len()
+	colors := uncolored(n)
+	saturation := make([]map[int]bool, n)
+	for v := range saturation {
+		saturation[v] = make(map[int]bool)
+	}
+
+	for step := 0; step < n; step++ {
+		v := mostSaturated(idx, colors, saturation)
+
+		color := 0
+		for saturation[v][color] {
+			color++
+		}
+		colors[v] = color
+
+		for _, w := range idx.adj[v] {
+			saturation[w][color] = true
+		}
+	}
+
+	return colors
+}
+
+// exactColoring improves the DSATUR coloring by one color at a time until it is impossible
+// or the context is done.
+func (idx *adjIndex) exactColoring(ctx context.Context) ([]int, error) {
+	best := idx.dsaturColoring()
+	for k := countColors(best) - 1; k > 0; k-- {
+		if err := ctx.Err(); err != nil {
+			return best, err
+		}
+
+		colors, ok, err := newColoringSearch(ctx, idx, k).run()
+		if err != nil {
+			return best, err
+		}
+		if !ok {
+			break
+		}
+		best = colors
+	}
+
+	return best, nil
+}
+
+// Color colors the vertices of an undirected graph so that no two adjacent vertices
+// share the same color, using the given strategy.
+//
+// Colors are numbered from 0, the function returns the vertex -> color map
+// and the number of used colors.
+func (g *Graph) Color(ctx context.Context, strategy ColoringStrategy) (map[string]int, int, error) {
+	if g.repr.IsDirected() {
+		return nil, 0, ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for v, neighbors := range idx.adj {
+		for _, w := range neighbors {
+			if v == w {
+				return nil, 0, ErrSelfLoop(idx.names[v])
+			}
+		}
+	}
+
+	colors, err := strategy.color(ctx, idx)
+	if colors == nil {
+		return nil, 0, err
+	}
+
+	coloring := make(map[string]int, len(colors))
+	for v, color := range colors {
+		coloring[idx.names[v]] = color
+	}
+
+	return coloring, countColors(colors), err
+}
+
+// coloringSearch holds the state of the backtracking search of a k-coloring.
+type coloringSearch struct {
+	ctx    context.Context
+	idx    *adjIndex
+	k      int
+	steps  int
+	colors []int
+	// forbidden[v][c] counts colored neighbors of v which have color c.
+	forbidden [][]int
+}
+
+func newColoringSearch(ctx context.Context, idx *adjIndex, k int) *coloringSearch {
+	forbidden := make([][]int, idx.len())
+	for v := range forbidden {
+		forbidden[v] = make([]int, k)
+	}
+
+	return &coloringSearch{
+		ctx:       ctx,
+		idx:       idx,
+		k:         k,
+		colors:    uncolored(idx.len()),
+		forbidden: forbidden,
+	}
+}
+
+func (s *coloringSearch) run() ([]int, bool, error) {
+	ok, err := s.search(0, 0)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return s.colors, true, nil
+}
+
+// search colors the next vertex, usedColors is the number of colors used so far,
+// new colors are introduced one at a time to skip symmetric colorings.
+func (s *coloringSearch) search(colored, usedColors int) (bool, error) {
+	if colored == s.idx.len() {
+		return true, nil
+	}
+
+	s.steps++
+	if s.steps%1024 == 0 {
+		if err := s.ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	v := s.next()
+	limit := usedColors + 1
+	if limit > s.k {
+		limit = s.k
+	}
+
+	for color := 0; color < limit; color++ {
+		if s.forbidden[v][color] > 0 {
+			continue
+		}
+
+		s.assign(v, color, 1)
+		used := usedColors
+		if color == usedColors {
+			used++
+		}
+
+		ok, err := s.search(colored+1, used)
+		if ok || err != nil {
+			return ok, err
+		}
+		s.assign(v, color, -1)
+	}
+
+	return false, nil
+}
+
+// assign colors (delta = 1) or uncolors (delta = -1) the vertex.
+func (s *coloringSearch) assign(v, color, delta int) {
+	if delta > 0 {
+		s.colors[v] = color
+	} else {
+		s.colors[v] = -1
+	}
+	for _, w := range s.idx.adj[v] {
+		s.forbidden[w][color] += delta
+	}
+}
+
+// next returns the uncolored vertex with the largest saturation degree,
+// ties are broken by the largest degree, which stays the same while the search backtracks.
+func (s *coloringSearch) next() int {
+	best, bestSat, bestDeg := -1, -1, -1
+	for v := range s.colors {
+		if s.colors[v] != -1 {
+			continue
+		}
+
+		sat := 0
+		for _, count := range s.forbidden[v] {
+			if count > 0 {
+				sat++
+			}
+		}
+
+		deg := len(s.idx.adj[v])
+		if sat > bestSat || (sat == bestSat && deg > bestDeg) {
+			best, bestSat, bestDeg = v, sat, deg
+		}
+	}
+	return best
+}
+
+// mostSaturated returns the uncolored vertex with the largest saturation degree,
+// ties are broken by the largest number of uncolored neighbors.
+func mostSaturated(idx *adjIndex, colors []int, saturation []map[int]bool) int {
+	best, bestSat, bestDeg := -1, -1, -1
+	for v := range colors {
+		if colors[v] != -1 {
+			continue
+		}
+
+		deg := 0
+		for _, w := range idx.adj[v] {
+			if colors[w] == -1 {
+				deg++
+			}
+		}
+
+		sat := len(saturation[v])
+		if sat > bestSat || (sat == bestSat && deg > bestDeg) {
+			best, bestSat, bestDeg = v, sat, deg
+		}
+	}
+	return best
+}
+
+func uncolored(n int) []int {
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	return colors
+}
+
+func countColors(colors []int) int {
+	count := 0
+	for _, color := range colors {
+		if color+1 > count {
+			count = color + 1
+		}
+	}
+	return count
+}
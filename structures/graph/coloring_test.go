@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphColor(t *testing.T) {
+	t.Parallel()
+	// Crown graph: a_i - b_j for i != j, greedy in insertion order needs 3 colors.
+	crown := [][2]string{{"a1", "b2"}, {"a1", "b3"}, {"a2", "b1"}, {"a2", "b3"}, {"a3", "b1"}, {"a3", "b2"}}
+	crownVertices := []string{"a1", "b1", "a2", "b2", "a3", "b3"}
+	cycle5 := [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"}, {"E", "A"}}
+	k4 := [][2]string{{"A", "B"}, {"A", "C"}, {"A", "D"}, {"B", "C"}, {"B", "D"}, {"C", "D"}}
+
+	tests := []struct {
+		name     string
+		vertices []string
+		edges    [][2]string
+		strategy ColoringStrategy
+		want     int
+	}{
+		{"greedy insertion order on crown", crownVertices, crown, GreedyColoring(InsertionOrder), 3},
+		{"greedy custom order on crown", crownVertices, crown, GreedyColoring(CustomOrder("a1", "a2", "a3")), 2},
+		{"greedy largest first on K4", []string{"A", "B", "C", "D"}, k4, GreedyColoring(LargestFirstOrder), 4},
+		{"greedy smallest last on C5", []string{"A", "B", "C", "D", "E"}, cycle5, GreedyColoring(SmallestLastOrder), 3},
+		{"dsatur on crown", crownVertices, crown, DSaturColoring(), 2},
+		{"dsatur on C5", []string{"A", "B", "C", "D", "E"}, cycle5, DSaturColoring(), 3},
+		{"exact on crown", crownVertices, crown, ExactColoring(), 2},
+		{"exact on C5", []string{"A", "B", "C", "D", "E"}, cycle5, ExactColoring(), 3},
+		{"exact on K4", []string{"A", "B", "C", "D"}, k4, ExactColoring(), 4},
+		{"exact on edgeless graph", []string{"A", "B"}, nil, ExactColoring(), 1},
+		{"exact on empty graph", nil, nil, ExactColoring(), 0},
+		{"zero strategy on crown", crownVertices, crown, ColoringStrategy{}, 3},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newGraph(t, WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					coloring, colors, err := g.Color(context.Background(), tt.strategy)
+
+					require.NoError(t, err)
+					require.Equal(t, tt.want, colors)
+					require.Len(t, coloring, len(tt.vertices))
+					for _, edge := range tt.edges {
+						require.NotEqual(t, coloring[edge[0]], coloring[edge[1]], "edge %v", edge)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestColoringOrders(t *testing.T) {
+	t.Parallel()
+	// A triangle A-B-C with a path C-D-E attached to it.
+	vertices := []string{"E", "D", "C", "B", "A"}
+	adj := map[string][]string{
+		"A": {"B", "C"},
+		"B": {"A", "C"},
+		"C": {"A", "B", "D"},
+		"D": {"C", "E"},
+		"E": {"D"},
+	}
+	neighbors := func(vertex string) []string {
+		return adj[vertex]
+	}
+
+	tests := []struct {
+		name  string
+		order ColoringOrder
+		want  []string
+	}{
+		{"insertion", InsertionOrder, []string{"E", "D", "C", "B", "A"}},
+		{"largest first", LargestFirstOrder, []string{"C", "D", "B", "A", "E"}},
+		{"smallest last", SmallestLastOrder, []string{"C", "A", "B", "D", "E"}},
+		{"custom", CustomOrder("A", "Z"), []string{"A", "Z", "E", "D", "C", "B", "A"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			order, err := tt.order(vertices, neighbors)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, order)
+		})
+	}
+}
+
+func TestGraphColorErrors(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("should fail on directed graph", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newDirected(t, WithVertices([]string{"A"}))
+				_, _, err := g.Color(context.Background(), DSaturColoring())
+				require.ErrorIs(t, err, ErrNotUndirected)
+			})
+
+			t.Run("should fail on unknown vertex in custom order", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t, WithVertices([]string{"A"}))
+				_, _, err := g.Color(context.Background(), GreedyColoring(CustomOrder("Z")))
+				require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+			})
+
+			t.Run("should return best coloring when context is done", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t,
+					WithVertices([]string{"a1", "b1", "a2", "b2", "a3", "b3"}),
+					WithEdges([][2]string{{"a1", "b2"}, {"a1", "b3"}, {"a2", "b1"}, {"a2", "b3"}, {"a3", "b1"}, {"a3", "b2"}}),
+				)
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				coloring, colors, err := g.Color(ctx, ExactColoring())
+				require.ErrorIs(t, err, context.Canceled)
+				require.Len(t, coloring, 6)
+				require.Positive(t, colors)
+			})
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package community implements community detection over undirected graphs.
+package community
+
+import (
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+// weighted is a weighted undirected graph with vertices numbered in the order
+// they were added to the original graph.
+//
+// adj[i][j] is the total weight of edges between i and j, a self-loop adds its weight twice to adj[i][i],
+// so that degree[i] is the sum of adj[i].
+type weighted struct {
+	names  []string
+	adj    []map[int]float64
+	degree []float64
+	// sum of all degrees, i.e. twice the total weight of edges
+	total float64
+}
+
+func newWeighted(g *graph.Graph) (*weighted, error) {
+	if g.IsDirected() {
+		return nil, graph.ErrNotUndirected
+	}
+
+	names := g.ListVertices()
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+
+	w := &weighted{
+		names:  names,
+		adj:    make([]map[int]float64, len(names)),
+		degree: make([]float64, len(names)),
+	}
+	for i := range w.adj {
+		w.adj[i] = make(map[int]float64)
+	}
+
+	for _, edge := range g.ListEdges() {
+		i, j := index[edge.Source], index[edge.Target]
+		w.adj[i][j] += edge.Weight
+		w.adj[j][i] += edge.Weight
+		w.degree[i] += edge.Weight
+		w.degree[j] += edge.Weight
+		w.total += 2 * edge.Weight
+	}
+
+	return w, nil
+}
+
+// modularity computes the modularity of the partition, community[i] is the community of vertex i.
+func (w *weighted) modularity(community []int) float64 {
+	if w.total == 0 {
+		return 0
+	}
+
+	in := make(map[int]float64)
+	tot := make(map[int]float64)
+	for i := range w.adj {
+		c := community[i]
+		tot[c] += w.degree[i]
+		for j, weight := range w.adj[i] {
+			if community[j] == c {
+				in[c] += weight
+			}
+		}
+	}
+
+	q := 0.0
+	for c := range tot {
+		q += in[c]/w.total - (tot[c]/w.total)*(tot[c]/w.total)
+	}
+	return q
+}
+
+// result converts the partition to the vertex -> community map,
+// communities are renumbered from 0 in the order of their first vertex.
+func (w *weighted) result(community []int) map[string]int {
+	result := make(map[string]int, len(community))
+	renumber := make(map[int]int)
+	for i, c := range community {
+		if _, ok := renumber[c]; !ok {
+			renumber[c] = len(renumber)
+		}
+		result[w.names[i]] = renumber[c]
+	}
+	return result
+}
+
+// Modularity computes the modularity of the given partition of an undirected graph:
+// the fraction of edges inside communities minus the expected fraction if edges were distributed at random.
+//
+// Vertices missing in the communities map are treated as separate communities.
+//
+// https://en.wikipedia.org/wiki/Modularity_(networks)
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func Modularity(g *graph.Graph, communities map[string]int) (float64, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return 0, err
+	}
+
+	community := make([]int, len(w.names))
+	next := 0
+	for _, c := range communities {
+		if c >= next {
+			next = c + 1
+		}
+	}
+	for i, name := range w.names {
+		c, ok := communities[name]
+		if !ok {
+			c = next
+			next++
+		}
+		community[i] = c
+	}
+
+	return w.modularity(community), nil
+}
@@ -0,0 +1,70 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+	"github.com/stretchr/testify/require"
+)
+
+// createTwoTriangles creates two triangles A-B-C and D-E-F connected by the edge C-D.
+func createTwoTriangles(t *testing.T) *graph.Graph {
+	t.Helper()
+	return graph.New(
+		graph.WithVertices([]string{"A", "B", "C", "D", "E", "F"}),
+		graph.WithEdges([][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "A"},
+			{"D", "E"}, {"E", "F"}, {"F", "D"},
+			{"C", "D"},
+		}),
+	)
+}
+
+func TestModularity(t *testing.T) {
+	t.Parallel()
+	g := createTwoTriangles(t)
+	tests := []struct {
+		name        string
+		communities map[string]int
+		want        float64
+	}{
+		{
+			name:        "should compute modularity of triangles",
+			communities: map[string]int{"A": 0, "B": 0, "C": 0, "D": 1, "E": 1, "F": 1},
+			want:        5.0 / 14,
+		},
+		{
+			name:        "should compute zero modularity of single community",
+			communities: map[string]int{"A": 0, "B": 0, "C": 0, "D": 0, "E": 0, "F": 0},
+			want:        0,
+		},
+		{
+			name:        "should treat missing vertices as singletons",
+			communities: map[string]int{},
+			want:        -(4.0 + 4 + 9 + 9 + 4 + 4) / 196,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Modularity(g, tt.communities)
+			require.NoError(t, err)
+			require.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestDirectedGraph(t *testing.T) {
+	t.Parallel()
+	g := graph.NewDirected(graph.WithVertices([]string{"A"}))
+
+	_, err := Modularity(g, nil)
+	require.ErrorIs(t, err, graph.ErrNotUndirected)
+
+	_, _, err = Louvain(g)
+	require.ErrorIs(t, err, graph.ErrNotUndirected)
+
+	_, _, err = LabelPropagation(g, 1)
+	require.ErrorIs(t, err, graph.ErrNotUndirected)
+}
@@ -0,0 +1,110 @@
+package community
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+// maxLabelPropagationIterations bounds the number of rounds,
+// since label propagation can oscillate on some graphs.
+const maxLabelPropagationIterations = 100
+
+// LabelPropagation finds communities of an undirected graph: every vertex starts with its own label
+// and repeatedly adopts the label shared by the largest number of its neighbors,
+// until every vertex has one of the most frequent labels among its neighbors.
+//
+// Vertices are visited in a random order and ties are broken randomly,
+// the seed makes the result reproducible.
+//
+// Returns the vertex -> community map, communities are numbered from 0, and the modularity of the partition.
+//
+// https://arxiv.org/abs/0709.2938
+//
+// Time complexity: O(k*e), where e is number of edges and k is number of iterations
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func LabelPropagation(g *graph.Graph, seed int64) (map[string]int, float64, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+
+	n := len(w.adj)
+	label := make([]int, n)
+	order := make([]int, n)
+	for i := range label {
+		label[i] = i
+		order[i] = i
+	}
+
+	for iteration := 0; iteration < maxLabelPropagationIterations; iteration++ {
+		rnd.Shuffle(n, func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+
+		for _, i := range order {
+			if best := w.dominantLabels(i, label); len(best) > 0 {
+				label[i] = best[rnd.Intn(len(best))]
+			}
+		}
+
+		if w.labelsStable(label) {
+			break
+		}
+	}
+
+	return w.result(label), w.modularity(label), nil
+}
+
+// dominantLabels returns the labels with the largest weight among the neighbors of i, sorted.
+func (w *weighted) dominantLabels(i int, label []int) []int {
+	weights := make(map[int]float64)
+	for j, weight := range w.adj[i] {
+		if j != i {
+			weights[label[j]] += weight
+		}
+	}
+
+	max := 0.0
+	for _, weight := range weights {
+		if weight > max {
+			max = weight
+		}
+	}
+
+	var best []int
+	for l, weight := range weights {
+		if weight == max {
+			best = append(best, l)
+		}
+	}
+	sort.Ints(best)
+
+	return best
+}
+
+// labelsStable checks that every vertex has one of the dominant labels of its neighbors.
+func (w *weighted) labelsStable(label []int) bool {
+	for i := range w.adj {
+		best := w.dominantLabels(i, label)
+		if len(best) == 0 {
+			continue
+		}
+
+		found := false
+		for _, l := range best {
+			if l == label[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelPropagation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should find components of disconnected cliques", func(t *testing.T) {
+		t.Parallel()
+		g := graph.New(
+			graph.WithVertices([]string{"A", "B", "C", "D", "E", "F", "G"}),
+			graph.WithEdges([][2]string{
+				{"A", "B"}, {"B", "C"}, {"C", "A"},
+				{"D", "E"}, {"E", "F"}, {"F", "D"},
+			}),
+		)
+
+		communities, modularity, err := LabelPropagation(g, 42)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"A": 0, "B": 0, "C": 0, "D": 1, "E": 1, "F": 1, "G": 2}, communities)
+		require.InDelta(t, 0.5, modularity, 1e-9)
+	})
+
+	t.Run("should be reproducible with the same seed", func(t *testing.T) {
+		t.Parallel()
+		g := createTwoTriangles(t)
+
+		first, q1, err := LabelPropagation(g, 7)
+		require.NoError(t, err)
+		second, q2, err := LabelPropagation(g, 7)
+		require.NoError(t, err)
+
+		require.Equal(t, first, second)
+		require.Equal(t, q1, q2)
+
+		want, err := Modularity(g, first)
+		require.NoError(t, err)
+		require.InDelta(t, want, q1, 1e-9)
+	})
+}
@@ -0,0 +1,144 @@
+package community
+
+import (
+	"sort"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+// epsilon is the minimal modularity gain treated as an improvement,
+// it prevents endless moves caused by floating point errors.
+const epsilon = 1e-12
+
+// Louvain finds communities of an undirected graph by greedy modularity optimization.
+//
+// Each pass moves vertices to the neighboring community with the largest modularity gain
+// until no move improves it, then collapses communities into single vertices and repeats
+// while the modularity grows. Vertices are processed in the order they were added to the graph,
+// so the result is deterministic.
+//
+// Returns the vertex -> community map, communities are numbered from 0, and the modularity of the partition.
+//
+// https://arxiv.org/abs/0803.0476
+//
+// Time complexity: O(e*log(v)) on average, where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func Louvain(g *graph.Graph) (map[string]int, float64, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// community of each original vertex
+	community := make([]int, len(w.names))
+	for i := range community {
+		community[i] = i
+	}
+
+	level := w
+	for {
+		moved, partition := level.moveVertices()
+		if !moved {
+			break
+		}
+
+		level, partition = level.aggregate(partition)
+		for i := range community {
+			community[i] = partition[community[i]]
+		}
+	}
+
+	return w.result(community), w.modularity(community), nil
+}
+
+// moveVertices runs the local moving phase and returns whether any vertex was moved
+// and the community of each vertex.
+func (w *weighted) moveVertices() (bool, []int) {
+	n := len(w.adj)
+	community := make([]int, n)
+	tot := make([]float64, n)
+	for i := range community {
+		community[i] = i
+		tot[i] = w.degree[i]
+	}
+
+	moved := false
+	for improved := true; improved; {
+		improved = false
+
+		for i := 0; i < n; i++ {
+			curr := community[i]
+			tot[curr] -= w.degree[i]
+
+			// Weight of edges from i into each neighboring community.
+			links := make(map[int]float64)
+			for j, weight := range w.adj[i] {
+				if j != i {
+					links[community[j]] += weight
+				}
+			}
+
+			candidates := make([]int, 0, len(links))
+			for c := range links {
+				candidates = append(candidates, c)
+			}
+			sort.Ints(candidates)
+
+			// Modularity gain of joining community c is proportional to
+			// links[c] - tot[c]*degree[i]/total, staying is preferred on ties.
+			best := curr
+			bestGain := links[curr] - tot[curr]*w.degree[i]/w.total
+			for _, c := range candidates {
+				gain := links[c] - tot[c]*w.degree[i]/w.total
+				if gain > bestGain+epsilon {
+					best, bestGain = c, gain
+				}
+			}
+
+			community[i] = best
+			tot[best] += w.degree[i]
+			if best != curr {
+				improved = true
+				moved = true
+			}
+		}
+	}
+
+	return moved, community
+}
+
+// aggregate collapses each community into a single vertex, the weight between two new vertices
+// is the sum of weights between their communities, and returns the new graph
+// with the renumbered partition.
+func (w *weighted) aggregate(community []int) (*weighted, []int) {
+	renumber := make(map[int]int)
+	partition := make([]int, len(community))
+	for i, c := range community {
+		if _, ok := renumber[c]; !ok {
+			renumber[c] = len(renumber)
+		}
+		partition[i] = renumber[c]
+	}
+
+	n := len(renumber)
+	agg := &weighted{
+		names:  make([]string, n),
+		adj:    make([]map[int]float64, n),
+		degree: make([]float64, n),
+		total:  w.total,
+	}
+	for c := range agg.adj {
+		agg.adj[c] = make(map[int]float64)
+	}
+
+	for i := range w.adj {
+		ci := partition[i]
+		agg.degree[ci] += w.degree[i]
+		for j, weight := range w.adj[i] {
+			agg.adj[ci][partition[j]] += weight
+		}
+	}
+
+	return agg, partition
+}
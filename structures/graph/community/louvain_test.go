@@ -0,0 +1,67 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLouvain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should split triangles", func(t *testing.T) {
+		t.Parallel()
+		communities, modularity, err := Louvain(createTwoTriangles(t))
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"A": 0, "B": 0, "C": 0, "D": 1, "E": 1, "F": 1}, communities)
+		require.InDelta(t, 5.0/14, modularity, 1e-9)
+	})
+
+	t.Run("should merge communities on the second level", func(t *testing.T) {
+		t.Parallel()
+		// Ring of 6 cliques K4 connected by single edges.
+		g := graph.NewMatrix()
+		for c := 0; c < 6; c++ {
+			for v := 0; v < 4; v++ {
+				require.NoError(t, g.AddVertex(string(rune('a'+c))+string(rune('0'+v))))
+			}
+		}
+		for c := 0; c < 6; c++ {
+			prefix := string(rune('a' + c))
+			for u := 0; u < 4; u++ {
+				for v := u + 1; v < 4; v++ {
+					require.NoError(t, g.AddEdge(prefix+string(rune('0'+u)), prefix+string(rune('0'+v))))
+				}
+			}
+			next := string(rune('a' + (c+1)%6))
+			require.NoError(t, g.AddEdge(prefix+"3", next+"0"))
+		}
+
+		communities, modularity, err := Louvain(g)
+		require.NoError(t, err)
+
+		want, err := Modularity(g, communities)
+		require.NoError(t, err)
+		require.InDelta(t, want, modularity, 1e-9)
+		require.Greater(t, modularity, 0.6)
+
+		// Vertices of the same clique stay together.
+		for c := 0; c < 6; c++ {
+			prefix := string(rune('a' + c))
+			for v := 1; v < 4; v++ {
+				require.Equal(t, communities[prefix+"0"], communities[prefix+string(rune('0'+v))])
+			}
+		}
+	})
+
+	t.Run("should handle graph without edges", func(t *testing.T) {
+		t.Parallel()
+		communities, modularity, err := Louvain(graph.New(graph.WithVertices([]string{"A", "B"})))
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"A": 0, "B": 1}, communities)
+		require.Zero(t, modularity)
+	})
+}
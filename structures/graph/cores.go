@@ -0,0 +1,129 @@
+package graph
+
+// coreDecomposition runs the Batagelj–Zaversnik algorithm: repeatedly removes a vertex of the smallest degree
+// keeping the vertices in bucket queues by their current degree.
+//
+// Returns the vertices in the order of removal and their core numbers,
+// self-loops and parallel edges are ignored.
+func (idx *adjIndex) coreDecomposition() ([]int, []int) {
+	n := idx.len()
+	degree := make([]int, n)
+	maxDegree := 0
+	for v, neighbors := range idx.adj {
+		for _, w := range neighbors {
+			if w != v {
+				degree[v]++
+			}
+		}
+		if degree[v] > maxDegree {
+			maxDegree = degree[v]
+		}
+	}
+
+	// Counting sort of the vertices by degree, bin[d] is the start of the bucket of degree d in vert.
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+	start := 0
+	for d, count := range bin {
+		bin[d] = start
+		start += count
+	}
+
+	vert := make([]int, n)
+	pos := make([]int, n)
+	for v, d := range degree {
+		pos[v] = bin[d]
+		vert[pos[v]] = v
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	for i := 0; i < n; i++ {
+		v := vert[i]
+		for _, u := range idx.adj[v] {
+			if u == v || degree[u] <= degree[v] {
+				continue
+			}
+
+			// Move u to the start of its bucket and shrink the bucket, so u falls into the previous one.
+			du := degree[u]
+			pu := pos[u]
+			pw := bin[du]
+			w := vert[pw]
+			if u != w {
+				vert[pu], vert[pw] = w, u
+				pos[u], pos[w] = pw, pu
+			}
+			bin[du]++
+			degree[u]--
+		}
+	}
+
+	// After the removal degree[v] is the core number of v.
+	return vert, degree
+}
+
+// CoreNumbers returns the core number of every vertex of the undirected graph:
+// the largest k such that the vertex belongs to the k-core, the maximal subgraph
+// in which every vertex has at least k neighbors.
+//
+// Self-loops and parallel edges are ignored.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) CoreNumbers() (map[string]int, error) {
+	if g.repr.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, core := idx.coreDecomposition()
+
+	cores := make(map[string]int, idx.len())
+	for v, k := range core {
+		cores[idx.names[v]] = k
+	}
+
+	return cores, nil
+}
+
+// DegeneracyOrdering returns the vertices of the undirected graph in the order of repeatedly removing
+// a vertex of the smallest degree, and the degeneracy of the graph: the largest core number.
+//
+// Every vertex has at most degeneracy neighbors later in the ordering.
+// Self-loops and parallel edges are ignored.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) DegeneracyOrdering() ([]string, int, error) {
+	if g.repr.IsDirected() {
+		return nil, 0, ErrNotUndirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order, core := idx.coreDecomposition()
+
+	degeneracy := 0
+	for _, k := range core {
+		if k > degeneracy {
+			degeneracy = k
+		}
+	}
+
+	return idx.toNames(order), degeneracy, nil
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphCoreNumbers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		vertices   []string
+		edges      [][2]string
+		want       map[string]int
+		degeneracy int
+	}{
+		{
+			name:     "should find cores of clique with tail",
+			vertices: []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: [][2]string{
+				{"A", "B"}, {"A", "C"}, {"A", "D"}, {"B", "C"}, {"B", "D"}, {"C", "D"},
+				{"D", "E"}, {"E", "F"}, {"F", "D"}, {"F", "G"},
+			},
+			want:       map[string]int{"A": 3, "B": 3, "C": 3, "D": 3, "E": 2, "F": 2, "G": 1},
+			degeneracy: 3,
+		},
+		{
+			name:       "should ignore self-loops and parallel edges",
+			vertices:   []string{"A", "B", "C"},
+			edges:      [][2]string{{"A", "B"}, {"B", "A"}, {"B", "B"}},
+			want:       map[string]int{"A": 1, "B": 1, "C": 0},
+			degeneracy: 1,
+		},
+		{
+			name:       "should handle empty graph",
+			want:       map[string]int{},
+			degeneracy: 0,
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newGraph(t, AllowParallelEdges(), AllowSelfLoops(), WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					cores, err := g.CoreNumbers()
+					require.NoError(t, err)
+					require.Equal(t, tt.want, cores)
+
+					order, degeneracy, err := g.DegeneracyOrdering()
+					require.NoError(t, err)
+					require.Equal(t, tt.degeneracy, degeneracy)
+					require.ElementsMatch(t, tt.vertices, order)
+
+					// Every vertex has at most degeneracy neighbors later in the ordering.
+					position := make(map[string]int, len(order))
+					for i, vertex := range order {
+						position[vertex] = i
+					}
+					for _, vertex := range order {
+						neighbors, err := g.Neighbors(vertex)
+						require.NoError(t, err)
+
+						later := 0
+						for _, neighbor := range neighbors {
+							if position[neighbor] > position[vertex] {
+								later++
+							}
+						}
+						require.LessOrEqual(t, later, degeneracy)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestGraphCoreNumbersRandom(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := createRandomGraph(t, repr.newGraph.bind(t), 60, 300, 3)
+
+			cores, err := g.CoreNumbers()
+			require.NoError(t, err)
+
+			// Every vertex of the k-core has at least k neighbors in the k-core.
+			for vertex, k := range cores {
+				neighbors, err := g.Neighbors(vertex)
+				require.NoError(t, err)
+
+				inCore := 0
+				for _, neighbor := range neighbors {
+					if cores[neighbor] >= k {
+						inCore++
+					}
+				}
+				require.GreaterOrEqual(t, inCore, k)
+			}
+		})
+	}
+}
+
+func TestGraphCoreNumbersDirected(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t)
+
+			_, err := g.CoreNumbers()
+			require.ErrorIs(t, err, ErrNotUndirected)
+
+			_, _, err = g.DegeneracyOrdering()
+			require.ErrorIs(t, err, ErrNotUndirected)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package graph
+
+// spanningForest is a BFS spanning forest of an undirected graph, which tracks edges by ID,
+// so parallel edges and self-loops are taken into account.
+type spanningForest struct {
+	names  []string
+	parent []int
+	depth  []int
+	// nonTree are the edges which are not in the forest ordered by ID, each of them closes a cycle.
+	nonTree [][2]int
+}
+
+func newSpanningForest(gr GraphRepr) (*spanningForest, error) {
+	if gr.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	idx, edges, err := newAdjIndexWithEdges(gr)
+	if err != nil {
+		return nil, err
+	}
+	names, index := idx.names, idx.index
+
+	type incidence struct {
+		to int
+		id int
+	}
+
+	inc := make([][]incidence, len(names))
+	for _, edge := range edges {
+		u, v := index[edge.Source], index[edge.Target]
+		inc[u] = append(inc[u], incidence{to: v, id: edge.ID})
+		if u != v {
+			inc[v] = append(inc[v], incidence{to: u, id: edge.ID})
+		}
+	}
+
+	f := &spanningForest{
+		names:  names,
+		parent: make([]int, len(names)),
+		depth:  make([]int, len(names)),
+	}
+
+	visited := make([]bool, len(names))
+	treeEdges := make(map[int]bool, len(names))
+	for root := range names {
+		if visited[root] {
+			continue
+		}
+
+		visited[root] = true
+		f.parent[root] = -1
+		queue := []int{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+
+			for _, e := range inc[u] {
+				if !visited[e.to] {
+					visited[e.to] = true
+					f.parent[e.to] = u
+					f.depth[e.to] = f.depth[u] + 1
+					treeEdges[e.id] = true
+					queue = append(queue, e.to)
+				}
+			}
+		}
+	}
+
+	for _, edge := range edges {
+		if !treeEdges[edge.ID] {
+			f.nonTree = append(f.nonTree, [2]int{index[edge.Source], index[edge.Target]})
+		}
+	}
+
+	return f, nil
+}
+
+// cycle returns the cycle closed by the non-tree edge u - v:
+// the tree path from u to v through their lowest common ancestor.
+func (f *spanningForest) cycle(u, v int) []string {
+	var up, down []int
+	for u != v {
+		if f.depth[u] >= f.depth[v] {
+			up = append(up, u)
+			u = f.parent[u]
+		} else {
+			down = append(down, v)
+			v = f.parent[v]
+		}
+	}
+	up = append(up, u)
+
+	for i := len(down) - 1; i >= 0; i-- {
+		up = append(up, down[i])
+	}
+
+	cycle := make([]string, len(up))
+	for i, w := range up {
+		cycle[i] = f.names[w]
+	}
+	return cycle
+}
+
+// hasUndirectedCycle checks whether the undirected edges form a cycle with a union-find over their endpoints:
+// an edge closes a cycle if its endpoints are already connected, so parallel edges and self-loops are cycles too.
+//
+// Time complexity: O(e*α(e)), where e is number of edges
+//
+// Space complexity: O(e), where e is number of edges
+func hasUndirectedCycle(edges map[int]Edge) bool {
+	parent := make(map[string]string, len(edges))
+	find := func(v string) string {
+		for {
+			p, ok := parent[v]
+			if !ok || p == v {
+				return v
+			}
+			// Path halving.
+			if pp, ok := parent[p]; ok {
+				parent[v] = pp
+			}
+			v = p
+		}
+	}
+
+	for _, edge := range edges {
+		u, v := find(edge.Source), find(edge.Target)
+		if u == v {
+			return true
+		}
+		parent[u] = v
+	}
+
+	return false
+}
+
+// FindUndirectedCycle checks whether the undirected graph has a cycle and returns one of them,
+// the cycle is closed by the edge from its last vertex to the first one.
+//
+// Parallel edges form a cycle of two vertices and a self-loop forms a cycle of one vertex.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) FindUndirectedCycle() (bool, []string, error) {
+	f, err := newSpanningForest(g.repr)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(f.nonTree) == 0 {
+		return false, nil, nil
+	}
+
+	edge := f.nonTree[0]
+	return true, f.cycle(edge[0], edge[1]), nil
+}
+
+// CycleBasis returns the fundamental cycle basis of the undirected graph relative to its BFS spanning forest:
+// a cycle for every edge outside of the forest, so the basis has e-v+c cycles, where c is number of components.
+//
+// Every cycle in the graph is a symmetric difference of cycles of the basis.
+// Cycles are ordered by ID of the edge which closes them, the closing edge connects the last vertex with the first one.
+//
+// Time complexity: O(v*e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v*e), where v is number of vertices, and e is number of edges
+func (g *Graph) CycleBasis() ([][]string, error) {
+	f, err := newSpanningForest(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	basis := make([][]string, 0, len(f.nonTree))
+	for _, edge := range f.nonTree {
+		basis = append(basis, f.cycle(edge[0], edge[1]))
+	}
+
+	return basis, nil
+}
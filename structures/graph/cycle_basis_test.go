@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphIsCyclic(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			t.Run("directed", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newDirected(t, WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}, {"B", "C"}}))
+
+				require.False(t, g.IsCyclic())
+
+				require.NoError(t, g.AddEdge("C", "A"))
+				require.True(t, g.IsCyclic())
+			})
+
+			t.Run("undirected", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t,
+					AllowParallelEdges(),
+					AllowSelfLoops(),
+					WithVertices([]string{"A", "B", "C", "D"}),
+					WithEdges([][2]string{{"A", "B"}, {"B", "C"}}),
+				)
+
+				// A single edge isn't a cycle in undirected graphs.
+				require.False(t, g.IsCyclic())
+
+				require.NoError(t, g.AddEdge("C", "A"))
+				require.True(t, g.IsCyclic())
+
+				require.NoError(t, g.DeleteEdge("C", "A"))
+				require.NoError(t, g.AddEdge("B", "A"))
+				require.True(t, g.IsCyclic())
+
+				require.NoError(t, g.DeleteEdge("A", "B"))
+				require.NoError(t, g.AddEdge("D", "D"))
+				require.True(t, g.IsCyclic())
+			})
+		})
+	}
+}
+
+func TestGraphFindUndirectedCycle(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		vertices []string
+		edges    [][2]string
+		want     []string
+	}{
+		{
+			name:     "should find no cycle in forest",
+			vertices: []string{"A", "B", "C", "D", "E"},
+			edges:    [][2]string{{"A", "B"}, {"B", "C"}, {"B", "D"}},
+		},
+		{
+			name:     "should find cycle",
+			vertices: []string{"A", "B", "C", "D", "E"},
+			edges:    [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"}, {"E", "C"}},
+			want:     []string{"D", "C", "E"},
+		},
+		{
+			name:     "should find cycle of parallel edges",
+			vertices: []string{"A", "B"},
+			edges:    [][2]string{{"A", "B"}, {"B", "A"}},
+			want:     []string{"B", "A"},
+		},
+		{
+			name:     "should find self-loop",
+			vertices: []string{"A", "B"},
+			edges:    [][2]string{{"A", "B"}, {"B", "B"}},
+			want:     []string{"B"},
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newGraph(t, AllowParallelEdges(), AllowSelfLoops(), WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					cyclic, cycle, err := g.FindUndirectedCycle()
+					require.NoError(t, err)
+					require.Equal(t, tt.want != nil, cyclic)
+					require.Equal(t, tt.want, cycle)
+				})
+			}
+
+			_, _, err := repr.newDirected(t).FindUndirectedCycle()
+			require.ErrorIs(t, err, ErrNotUndirected)
+		})
+	}
+}
+
+func TestGraphCycleBasis(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D", "E", "F", "G"}),
+				WithEdges([][2]string{
+					{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "A"}, {"A", "C"},
+					{"E", "F"}, {"F", "G"}, {"G", "E"},
+				}),
+			)
+
+			basis, err := g.CycleBasis()
+			require.NoError(t, err)
+			require.Equal(t, [][]string{{"B", "A", "C"}, {"C", "A", "D"}, {"F", "E", "G"}}, basis)
+			// e - v + c
+			require.Len(t, basis, 8-7+2)
+
+			for _, cycle := range basis {
+				for i := range cycle {
+					require.True(t, g.HasEdge(cycle[i], cycle[(i+1)%len(cycle)]))
+				}
+			}
+
+			basis, err = repr.newGraph(t, WithVertices([]string{"A", "B"}), WithEdges([][2]string{{"A", "B"}})).CycleBasis()
+			require.NoError(t, err)
+			require.Empty(t, basis)
+
+			_, err = repr.newDirected(t).CycleBasis()
+			require.ErrorIs(t, err, ErrNotUndirected)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package graph
+
+// SimpleCycles finds elementary cycles of a directed graph using Johnson's algorithm
+// and passes each of them to the callback as soon as it is found.
+//
+// A cycle is passed as the list of its vertices in traversal order,
+// the first vertex is not repeated at the end, e.g. [A B C] means A -> B -> C -> A.
+// A self-loop is reported as a cycle of a single vertex.
+//
+// The search stops after limit cycles have been reported, if limit <= 0 all cycles are reported.
+// Keep in mind that the number of cycles can grow exponentially with the size of the graph.
+//
+// https://www.cs.tufts.edu/comp/150GA/homeworks/hw1/Johnson%2075.PDF
+//
+// Time complexity: O((v+e)(c+1)), where v is number of vertices, e is number of edges and c is number of cycles
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) SimpleCycles(limit int, callback func(cycle []string)) error {
+	if !g.repr.IsDirected() {
+		return ErrNotDirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return err
+	}
+
+	newJohnson(idx, limit, callback).run()
+
+	return nil
+}
+
+// johnson holds the state of the Johnson's algorithm.
+type johnson struct {
+	idx      *adjIndex
+	limit    int
+	found    int
+	callback func(cycle []string)
+
+	start   int
+	allowed []bool
+	blocked []bool
+	blockB  []map[int]bool
+	stack   []int
+}
+
+func newJohnson(idx *adjIndex, limit int, callback func(cycle []string)) *johnson {
+	n := idx.len()
+	j := &johnson{
+		idx:      idx,
+		limit:    limit,
+		callback: callback,
+		allowed:  make([]bool, n),
+		blocked:  make([]bool, n),
+		blockB:   make([]map[int]bool, n),
+		stack:    make([]int, 0, n),
+	}
+	for i := range j.blockB {
+		j.blockB[i] = make(map[int]bool)
+	}
+	return j
+}
+
+func (j *johnson) done() bool {
+	return j.limit > 0 && j.found >= j.limit
+}
+
+func (j *johnson) run() {
+	for s := 0; s < j.idx.len() && !j.done(); s++ {
+		// Search only inside the strongly connected component of s
+		// in the subgraph induced by the vertices s, s+1, ..., n-1.
+		for i := range j.allowed {
+			j.allowed[i] = false
+		}
+		for _, v := range j.component(s) {
+			j.allowed[v] = true
+			j.blocked[v] = false
+			j.blockB[v] = make(map[int]bool)
+		}
+
+		j.start = s
+		j.circuit(s)
+	}
+}
+
+func (j *johnson) circuit(v int) bool {
+	found := false
+
+	j.stack = append(j.stack, v)
+	j.blocked[v] = true
+
+	for _, w := range j.idx.adj[v] {
+		if !j.allowed[w] {
+			continue
+		}
+		if j.done() {
+			break
+		}
+
+		if w == j.start {
+			j.found++
+			j.callback(j.idx.toNames(j.stack))
+			found = true
+		} else if !j.blocked[w] && j.circuit(w) {
+			found = true
+		}
+	}
+
+	if found {
+		j.unblock(v)
+	} else {
+		for _, w := range j.idx.adj[v] {
+			if j.allowed[w] {
+				j.blockB[w][v] = true
+			}
+		}
+	}
+
+	j.stack = j.stack[:len(j.stack)-1]
+
+	return found
+}
+
+func (j *johnson) unblock(u int) {
+	j.blocked[u] = false
+	for w := range j.blockB[u] {
+		delete(j.blockB[u], w)
+		if j.blocked[w] {
+			j.unblock(w)
+		}
+	}
+}
+
+// component returns the strongly connected component which contains vertex s
+// in the subgraph induced by the vertices with index >= s (Tarjan's algorithm).
+func (j *johnson) component(s int) []int {
+	var (
+		counter  int
+		index    = make(map[int]int)
+		lowlink  = make(map[int]int)
+		onStack  = make(map[int]bool)
+		stack    []int
+		result   []int
+		strongly func(v int)
+	)
+
+	strongly = func(v int) {
+		index[v] = counter
+		lowlink[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range j.idx.adj[v] {
+			if w < s {
+				continue
+			}
+			if _, visited := index[w]; !visited {
+				strongly(w)
+				if lowlink[w] < lowlink[v] {
+					lowlink[v] = lowlink[w]
+				}
+			} else if onStack[w] && index[w] < lowlink[v] {
+				lowlink[v] = index[w]
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			// s is the root of the search, so its component is popped last.
+			if v == s {
+				result = component
+			}
+		}
+	}
+
+	strongly(s)
+
+	return result
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphSimpleCycles(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		vertices []string
+		edges    [][2]string
+		limit    int
+		want     [][]string
+	}{
+		{
+			name:     "should find no cycles in DAG",
+			vertices: []string{"A", "B", "C"},
+			edges:    [][2]string{{"A", "B"}, {"B", "C"}, {"A", "C"}},
+			want:     nil,
+		},
+		{
+			name:     "should find all cycles",
+			vertices: []string{"A", "B", "C", "D", "E", "F"},
+			edges: [][2]string{
+				{"A", "B"}, {"B", "C"}, {"C", "E"}, {"E", "F"}, {"E", "D"}, {"D", "B"},
+				{"C", "A"}, {"F", "F"},
+			},
+			want: [][]string{
+				{"A", "B", "C"},
+				{"B", "C", "E", "D"},
+				{"F"},
+			},
+		},
+		{
+			name:     "should find cycles of complete digraph",
+			vertices: []string{"A", "B", "C"},
+			edges:    [][2]string{{"A", "B"}, {"B", "A"}, {"B", "C"}, {"C", "B"}, {"A", "C"}, {"C", "A"}},
+			want: [][]string{
+				{"A", "B"},
+				{"A", "B", "C"},
+				{"A", "C"},
+				{"A", "C", "B"},
+				{"B", "C"},
+			},
+		},
+		{
+			name:     "should stop at limit",
+			vertices: []string{"A", "B", "C"},
+			edges:    [][2]string{{"A", "B"}, {"B", "A"}, {"B", "C"}, {"C", "B"}, {"A", "C"}, {"C", "A"}},
+			limit:    2,
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newDirected(t, AllowSelfLoops(), WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					var got [][]string
+					err := g.SimpleCycles(tt.limit, func(cycle []string) {
+						got = append(got, cycle)
+					})
+
+					require.NoError(t, err)
+					if tt.limit > 0 {
+						require.Len(t, got, tt.limit)
+					} else {
+						require.ElementsMatch(t, tt.want, got)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestGraphSimpleCyclesUndirected(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t, WithVertices([]string{"A", "B"}), WithEdges([][2]string{{"A", "B"}}))
+			err := g.SimpleCycles(0, func(cycle []string) {})
+			require.ErrorIs(t, err, ErrNotDirected)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Patch is the difference between two graphs, which can be shown to a reviewer
+// or replayed on another graph with Graph.Apply.
+//
+// Edges are matched by their endpoints and weights, IDs aren't compared.
+// Endpoints of undirected edges are ordered, so the source is not greater than the target.
+// All lists are sorted, so the text and the JSON representations of a patch are stable.
+type Patch struct {
+	Directed        bool           `json:"directed"`
+	RemovedVertices []string       `json:"removedVertices,omitempty"`
+	AddedVertices   []string       `json:"addedVertices,omitempty"`
+	RemovedEdges    []PatchEdge    `json:"removedEdges,omitempty"`
+	AddedEdges      []PatchEdge    `json:"addedEdges,omitempty"`
+	ChangedWeights  []WeightChange `json:"changedWeights,omitempty"`
+}
+
+// PatchEdge is an edge of the patch identified by its endpoints and weight.
+type PatchEdge struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	Weight float64 `json:"weight"`
+}
+
+// WeightChange is an edge which weight changed from From to To.
+type WeightChange struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	From   float64 `json:"from"`
+	To     float64 `json:"to"`
+}
+
+// Diff returns the patch which turns the graph a into the graph b.
+//
+// Parallel edges with equal weights are matched first,
+// the rest of parallel edges are matched in increasing order of weights and reported as weight changes.
+//
+// Time complexity: O((v+e)*log(v+e)), where v is number of vertices, and e is number of edges of both graphs
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges of both graphs
+func Diff(a, b *Graph) (*Patch, error) {
+	if a.repr.IsDirected() != b.repr.IsDirected() {
+		return nil, ErrDirectionMismatch
+	}
+
+	directed := a.repr.IsDirected()
+	patch := &Patch{Directed: directed}
+
+	patch.RemovedVertices = missingVertices(a, b)
+	patch.AddedVertices = missingVertices(b, a)
+
+	weightsA := groupWeights(a.repr.ListEdges(), directed)
+	weightsB := groupWeights(b.repr.ListEdges(), directed)
+
+	pairs := make([][2]string, 0, len(weightsA)+len(weightsB))
+	for pair := range weightsA {
+		pairs = append(pairs, pair)
+	}
+	for pair := range weightsB {
+		if _, ok := weightsA[pair]; !ok {
+			pairs = append(pairs, pair)
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	for _, pair := range pairs {
+		removed, added := unmatchedWeights(weightsA[pair], weightsB[pair])
+
+		for len(removed) > 0 && len(added) > 0 {
+			patch.ChangedWeights = append(patch.ChangedWeights, WeightChange{
+				Source: pair[0], Target: pair[1], From: removed[0], To: added[0],
+			})
+			removed, added = removed[1:], added[1:]
+		}
+		for _, weight := range removed {
+			patch.RemovedEdges = append(patch.RemovedEdges, PatchEdge{Source: pair[0], Target: pair[1], Weight: weight})
+		}
+		for _, weight := range added {
+			patch.AddedEdges = append(patch.AddedEdges, PatchEdge{Source: pair[0], Target: pair[1], Weight: weight})
+		}
+	}
+
+	return patch, nil
+}
+
+// missingVertices returns the sorted vertices of a which are missing in b.
+func missingVertices(a, b *Graph) []string {
+	var missing []string
+	for _, vertex := range a.repr.ListVertices() {
+		if !b.repr.HasVertex(vertex) {
+			missing = append(missing, vertex)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// pairKey returns the endpoints of the edge, ordered for undirected graphs.
+func pairKey(source, target string, directed bool) [2]string {
+	if !directed && source > target {
+		return [2]string{target, source}
+	}
+	return [2]string{source, target}
+}
+
+// groupWeights groups the weights of edges by their endpoints, weights of every pair are sorted.
+func groupWeights(edges []Edge, directed bool) map[[2]string][]float64 {
+	weights := make(map[[2]string][]float64)
+	for _, edge := range edges {
+		pair := pairKey(edge.Source, edge.Target, directed)
+		weights[pair] = append(weights[pair], edge.Weight)
+	}
+	for _, list := range weights {
+		sort.Float64s(list)
+	}
+	return weights
+}
+
+// unmatchedWeights removes the weights present in both sorted lists.
+func unmatchedWeights(a, b []float64) (removed, added []float64) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case a[i] < b[j]:
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	removed = append(removed, a[i:]...)
+	added = append(added, b[j:]...)
+	return removed, added
+}
+
+// IsEmpty checks that the patch has no changes.
+func (p *Patch) IsEmpty() bool {
+	return len(p.RemovedVertices) == 0 && len(p.AddedVertices) == 0 &&
+		len(p.RemovedEdges) == 0 && len(p.AddedEdges) == 0 && len(p.ChangedWeights) == 0
+}
+
+// String returns the patch one change per line: removed vertices, added vertices,
+// removed edges, added edges and changed weights, e.g.
+//
+//	- vertex A
+//	+ vertex D
+//	- edge A -> B 1
+//	+ edge C -> D 2
+//	~ edge B -> C 1 => 3
+func (p *Patch) String() string {
+	arrow := "--"
+	if p.Directed {
+		arrow = "->"
+	}
+
+	var buf bytes.Buffer
+	for _, vertex := range p.RemovedVertices {
+		fmt.Fprintf(&buf, "- vertex %v\n", vertex)
+	}
+	for _, vertex := range p.AddedVertices {
+		fmt.Fprintf(&buf, "+ vertex %v\n", vertex)
+	}
+	for _, edge := range p.RemovedEdges {
+		fmt.Fprintf(&buf, "- edge %v %v %v %v\n", edge.Source, arrow, edge.Target, formatWeight(edge.Weight))
+	}
+	for _, edge := range p.AddedEdges {
+		fmt.Fprintf(&buf, "+ edge %v %v %v %v\n", edge.Source, arrow, edge.Target, formatWeight(edge.Weight))
+	}
+	for _, change := range p.ChangedWeights {
+		fmt.Fprintf(&buf, "~ edge %v %v %v %v => %v\n",
+			change.Source, arrow, change.Target, formatWeight(change.From), formatWeight(change.To))
+	}
+
+	return buf.String()
+}
+
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'g', -1, 64)
+}
+
+// Apply replays the patch on the graph: removes edges and vertices, adds vertices and edges and changes weights.
+//
+// The patch is applied to a copy of the graph first, so if any change can't be applied
+// (e.g. a removed edge doesn't exist) the error is returned and the graph is left unchanged.
+// Apply must not run concurrently with other mutations of the graph.
+//
+// Time complexity: O(v+e+k), where v is number of vertices, e is number of edges
+// and k is number of changes (multiplied by the cost of each of them)
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Apply(p *Patch) error {
+	if p.Directed != g.repr.IsDirected() {
+		return ErrDirectionMismatch
+	}
+
+	dryRun := g.repr.clone()
+	if err := applyPatch(dryRun, p); err != nil {
+		return err
+	}
+
+	return applyPatch(g.repr, p)
+}
+
+func applyPatch(gr GraphRepr, p *Patch) error {
+	edges := make(map[[2]string][]Edge)
+	for _, edge := range gr.ListEdges() {
+		pair := pairKey(edge.Source, edge.Target, p.Directed)
+		edges[pair] = append(edges[pair], edge)
+	}
+
+	// take removes the first edge between the endpoints with the given weight from the index.
+	take := func(source, target string, weight float64) (Edge, error) {
+		pair := pairKey(source, target, p.Directed)
+		for i, edge := range edges[pair] {
+			if edge.Weight == weight {
+				edges[pair] = append(edges[pair][:i], edges[pair][i+1:]...)
+				return edge, nil
+			}
+		}
+		return Edge{}, ErrEdgeNotFound(source, target)
+	}
+
+	for _, removed := range p.RemovedEdges {
+		edge, err := take(removed.Source, removed.Target, removed.Weight)
+		if err != nil {
+			return err
+		}
+		if err := gr.DeleteEdgeByID(edge.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, vertex := range p.RemovedVertices {
+		if err := gr.DeleteVertex(vertex); err != nil {
+			return err
+		}
+	}
+
+	for _, vertex := range p.AddedVertices {
+		if err := gr.AddVertex(vertex); err != nil {
+			return err
+		}
+	}
+
+	for _, added := range p.AddedEdges {
+		if _, err := gr.AddWeightedEdge(added.Source, added.Target, added.Weight); err != nil {
+			return err
+		}
+	}
+
+	// The weight is changed by replacing the edge with the same ID.
+	for _, change := range p.ChangedWeights {
+		edge, err := take(change.Source, change.Target, change.From)
+		if err != nil {
+			return err
+		}
+		if err := gr.DeleteEdgeByID(edge.ID); err != nil {
+			return err
+		}
+		edge.Weight = change.To
+		if err := gr.restoreEdge(edge); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
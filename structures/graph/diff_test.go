@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			a := repr.newDirected(t,
+				AllowParallelEdges(),
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithWeightedEdges([]Edge{
+					{Source: "A", Target: "B", Weight: 1},
+					{Source: "B", Target: "C", Weight: 2},
+					{Source: "C", Target: "D", Weight: 3},
+					{Source: "C", Target: "D", Weight: 4},
+				}),
+			)
+			b := repr.newDirected(t,
+				AllowParallelEdges(),
+				WithVertices([]string{"E", "C", "B", "A"}),
+				WithWeightedEdges([]Edge{
+					{Source: "B", Target: "C", Weight: 5},
+					{Source: "A", Target: "B", Weight: 1},
+					{Source: "A", Target: "E", Weight: 1},
+					{Source: "A", Target: "B", Weight: 1},
+				}),
+			)
+
+			patch, err := Diff(a, b)
+			require.NoError(t, err)
+			require.Equal(t, &Patch{
+				Directed:        true,
+				RemovedVertices: []string{"D"},
+				AddedVertices:   []string{"E"},
+				RemovedEdges:    []PatchEdge{{"C", "D", 3}, {"C", "D", 4}},
+				AddedEdges:      []PatchEdge{{"A", "B", 1}, {"A", "E", 1}},
+				ChangedWeights:  []WeightChange{{"B", "C", 2, 5}},
+			}, patch)
+
+			require.NoError(t, a.Apply(patch))
+			again, err := Diff(a, b)
+			require.NoError(t, err)
+			require.True(t, again.IsEmpty())
+		})
+	}
+}
+
+func TestDiffUndirectedEndpoints(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			a := repr.newGraph(t, WithVertices([]string{"A", "B"}), WithEdges([][2]string{{"B", "A"}}))
+			b := repr.newGraph(t, WithVertices([]string{"A", "B"}), WithEdges([][2]string{{"A", "B"}}))
+
+			patch, err := Diff(a, b)
+			require.NoError(t, err)
+			require.True(t, patch.IsEmpty())
+
+			_, err = Diff(a, repr.newDirected(t))
+			require.ErrorIs(t, err, ErrDirectionMismatch)
+		})
+	}
+}
+
+func TestPatchString(t *testing.T) {
+	t.Parallel()
+	patch := &Patch{
+		Directed:        true,
+		RemovedVertices: []string{"A"},
+		AddedVertices:   []string{"D"},
+		RemovedEdges:    []PatchEdge{{"A", "B", 1}},
+		AddedEdges:      []PatchEdge{{"C", "D", 2.5}},
+		ChangedWeights:  []WeightChange{{"B", "C", 1, 3}},
+	}
+
+	require.Equal(t, "- vertex A\n+ vertex D\n- edge A -> B 1\n+ edge C -> D 2.5\n~ edge B -> C 1 => 3\n", patch.String())
+
+	patch.Directed = false
+	require.Equal(t, "- vertex A\n+ vertex D\n- edge A -- B 1\n+ edge C -- D 2.5\n~ edge B -- C 1 => 3\n", patch.String())
+}
+
+func TestPatchJSON(t *testing.T) {
+	t.Parallel()
+	patch := &Patch{
+		Directed:       true,
+		AddedVertices:  []string{"D"},
+		AddedEdges:     []PatchEdge{{"C", "D", 2}},
+		ChangedWeights: []WeightChange{{"B", "C", 1, 3}},
+	}
+
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"directed": true,
+		"addedVertices": ["D"],
+		"addedEdges": [{"source": "C", "target": "D", "weight": 2}],
+		"changedWeights": [{"source": "B", "target": "C", "from": 1, "to": 3}]
+	}`, string(data))
+
+	var decoded Patch
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, patch, &decoded)
+}
+
+func TestGraphApply(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithWeightedEdges([]Edge{{Source: "A", Target: "B", Weight: 1}, {Source: "B", Target: "C", Weight: 2}}),
+			)
+			id := g.EdgesBetween("B", "C")[0].ID
+
+			require.NoError(t, g.Apply(&Patch{
+				Directed:       false,
+				RemovedEdges:   []PatchEdge{{"A", "B", 1}},
+				AddedVertices:  []string{"D"},
+				AddedEdges:     []PatchEdge{{"C", "D", 4}},
+				ChangedWeights: []WeightChange{{"B", "C", 2, 3}},
+			}))
+			require.Equal(t, []string{"A", "B", "C", "D"}, g.ListVertices())
+			require.False(t, g.HasEdge("A", "B"))
+			// The changed edge keeps its ID.
+			require.Equal(t, []Edge{{ID: id, Source: "B", Target: "C", Weight: 3}}, g.EdgesBetween("B", "C"))
+			require.Equal(t, 4.0, g.EdgesBetween("C", "D")[0].Weight)
+
+			// A failing patch leaves the graph unchanged.
+			before := g.ListEdges()
+			err := g.Apply(&Patch{
+				Directed:      false,
+				AddedVertices: []string{"E"},
+				RemovedEdges:  []PatchEdge{{"B", "C", 100}},
+			})
+			require.EqualError(t, err, ErrEdgeNotFound("B", "C").Error())
+			require.False(t, g.HasVertex("E"))
+			require.Equal(t, before, g.ListEdges())
+
+			require.ErrorIs(t, g.Apply(&Patch{Directed: true}), ErrDirectionMismatch)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package graph
+
+// Dominators computes the immediate dominators of the vertices reachable from the root
+// with the Cooper–Harvey–Kennedy iterative algorithm and returns the dominator tree as a parent map.
+//
+// A vertex d dominates a vertex v if every path from the root to v passes through d,
+// the immediate dominator of v is its closest strict dominator.
+// The root and the vertices unreachable from it are absent from the result.
+//
+// Time complexity: O(v*e) in the worst case and close to O(v+e) on control-flow graphs,
+// where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Dominators(root string) (map[string]string, error) {
+	if !g.repr.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := idx.index[root]
+	if !ok {
+		return nil, ErrVertexNotFound(root)
+	}
+
+	order := idx.postorder(r)
+
+	// postNum[v] is the position of v in the postorder, -1 for unreachable vertices.
+	postNum := make([]int, idx.len())
+	for i := range postNum {
+		postNum[i] = -1
+	}
+	for i, v := range order {
+		postNum[v] = i
+	}
+
+	preds := make([][]int, idx.len())
+	for u, successors := range idx.adj {
+		if postNum[u] == -1 {
+			continue
+		}
+		for _, v := range successors {
+			preds[v] = append(preds[v], u)
+		}
+	}
+
+	idom := make([]int, idx.len())
+	for i := range idom {
+		idom[i] = -1
+	}
+	idom[r] = r
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for postNum[a] < postNum[b] {
+				a = idom[a]
+			}
+			for postNum[b] < postNum[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		// Reverse postorder, skipping the root which is the last in the postorder.
+		for i := len(order) - 2; i >= 0; i-- {
+			v := order[i]
+
+			newIdom := -1
+			for _, p := range preds[v] {
+				if idom[p] == -1 {
+					continue
+				}
+				if newIdom == -1 {
+					newIdom = p
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+
+			if idom[v] != newIdom {
+				idom[v] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	dominators := make(map[string]string, len(order)-1)
+	for _, v := range order {
+		if v != r {
+			dominators[idx.names[v]] = idx.names[idom[v]]
+		}
+	}
+
+	return dominators, nil
+}
+
+// postorder returns the vertices reachable from the start in the depth-first postorder.
+func (idx *adjIndex) postorder(start int) []int {
+	type frame struct {
+		vertex int
+		next   int
+	}
+
+	visited := make([]bool, idx.len())
+	visited[start] = true
+	stack := []frame{{vertex: start}}
+	order := make([]int, 0, idx.len())
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next < len(idx.adj[top.vertex]) {
+			w := idx.adj[top.vertex][top.next]
+			top.next++
+			if !visited[w] {
+				visited[w] = true
+				stack = append(stack, frame{vertex: w})
+			}
+			continue
+		}
+
+		order = append(order, top.vertex)
+		stack = stack[:len(stack)-1]
+	}
+
+	return order
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphDominators(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		vertices []string
+		edges    [][2]string
+		root     string
+		want     map[string]string
+	}{
+		{
+			name:     "should find dominators of diamond",
+			vertices: []string{"A", "B", "C", "D"},
+			edges:    [][2]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}},
+			root:     "A",
+			want:     map[string]string{"B": "A", "C": "A", "D": "A"},
+		},
+		{
+			name:     "should find dominators of loop",
+			vertices: []string{"entry", "header", "body", "latch", "exit"},
+			edges: [][2]string{
+				{"entry", "header"}, {"header", "body"}, {"body", "latch"},
+				{"latch", "header"}, {"header", "exit"},
+			},
+			root: "entry",
+			want: map[string]string{"header": "entry", "body": "header", "latch": "body", "exit": "header"},
+		},
+		{
+			// Example from "A Simple, Fast Dominance Algorithm" by Cooper, Harvey and Kennedy.
+			name:     "should find dominators of irreducible graph",
+			vertices: []string{"6", "5", "4", "3", "2", "1"},
+			edges: [][2]string{
+				{"6", "5"}, {"6", "4"}, {"5", "1"}, {"4", "2"}, {"4", "3"},
+				{"2", "1"}, {"2", "3"}, {"3", "2"}, {"1", "2"},
+			},
+			root: "6",
+			want: map[string]string{"5": "6", "4": "6", "3": "6", "2": "6", "1": "6"},
+		},
+		{
+			name:     "should skip unreachable vertices",
+			vertices: []string{"A", "B", "C", "D"},
+			edges:    [][2]string{{"A", "B"}, {"C", "B"}, {"C", "D"}},
+			root:     "A",
+			want:     map[string]string{"B": "A"},
+		},
+		{
+			name:     "should return empty tree of single vertex",
+			vertices: []string{"A"},
+			root:     "A",
+			want:     map[string]string{},
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newDirected(t, WithVertices(tt.vertices), WithEdges(tt.edges))
+
+					got, err := g.Dominators(tt.root)
+					require.NoError(t, err)
+					require.Equal(t, tt.want, got)
+				})
+			}
+		})
+	}
+}
+
+func TestGraphDominatorsErrors(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := repr.newDirected(t, WithVertices([]string{"A"})).Dominators("Z")
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			_, err = repr.newGraph(t, WithVertices([]string{"A"})).Dominators("A")
+			require.ErrorIs(t, err, ErrNotDirected)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package graph
+
+import "sort"
+
+// Edge is an edge of the graph identified by the ID,
+// which is unique within the graph and isn't reused after the edge is deleted.
+//
+// For undirected graphs Source and Target keep the order the edge was added in.
+type Edge struct {
+	ID     int
+	Source string
+	Target string
+	// Weight is DefaultWeight for edges added without a weight.
+	Weight float64
+}
+
+// DefaultWeight is the weight of edges added without a weight,
+// so weighted algorithms treat unweighted graphs as if every edge had a unit length.
+const DefaultWeight = 1.0
+
+// edgePolicy defines which edges are accepted by the graph.
+//
+// By default the graph is simple: parallel edges and self-loops are rejected.
+type edgePolicy struct {
+	parallelEdges bool
+	selfLoops     bool
+}
+
+// AllowParallelEdges allows adding several edges between the same pair of vertices,
+// making the graph a multigraph.
+//
+// Policy options must precede WithEdges, since options are applied in order.
+func AllowParallelEdges() GraphOption {
+	return func(gr GraphRepr) {
+		policy := gr.edgePolicy()
+		policy.parallelEdges = true
+		gr.setEdgePolicy(policy)
+	}
+}
+
+// AllowSelfLoops allows adding edges which connect a vertex to itself.
+//
+// Policy options must precede WithEdges, since options are applied in order.
+func AllowSelfLoops() GraphOption {
+	return func(gr GraphRepr) {
+		policy := gr.edgePolicy()
+		policy.selfLoops = true
+		gr.setEdgePolicy(policy)
+	}
+}
+
+// checkEdge checks that the edge source -> target can be added under the policy,
+// exists tells whether there is already an edge between the vertices.
+func (p edgePolicy) checkEdge(source, target string, exists bool) error {
+	if source == target && !p.selfLoops {
+		return ErrSelfLoop(source)
+	}
+
+	if exists && !p.parallelEdges {
+		return ErrEdgeAlreadyExists(source, target)
+	}
+
+	return nil
+}
+
+// connects checks that the edge connects source with target,
+// in both directions for undirected graphs.
+func (e Edge) connects(source, target string, undirected bool) bool {
+	if e.Source == source && e.Target == target {
+		return true
+	}
+	return undirected && e.Source == target && e.Target == source
+}
+
+// arc is a neighbor of a vertex with the lightest weight of the edges leading to it.
+type arc struct {
+	vertex string
+	weight float64
+}
+
+// appendArc adds the edge to the vertex to the arcs keeping the lightest weight of parallel edges,
+// seen maps the vertices to their positions in arcs.
+func appendArc(arcs []arc, seen map[string]int, vertex string, weight float64) []arc {
+	if k, ok := seen[vertex]; ok {
+		if weight < arcs[k].weight {
+			arcs[k].weight = weight
+		}
+		return arcs
+	}
+
+	seen[vertex] = len(arcs)
+	return append(arcs, arc{vertex: vertex, weight: weight})
+}
+
+// sortedEdges returns the edges ordered by ID.
+func sortedEdges(edges map[int]Edge) []Edge {
+	result := make([]Edge, 0, len(edges))
+	for _, edge := range edges {
+		result = append(result, edge)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
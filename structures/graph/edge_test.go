@@ -0,0 +1,235 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphEdgePolicy(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			simple := tt.newGraph(t, WithVertices([]string{"A", "B"}))
+			require.NoError(t, simple.AddEdge("A", "B"))
+			require.EqualError(t, simple.AddEdge("A", "B"), ErrEdgeAlreadyExists("A", "B").Error())
+			require.EqualError(t, simple.AddEdge("A", "A"), ErrSelfLoop("A").Error())
+			require.EqualError(t, simple.AddEdge("A", "Z"), ErrVertexNotFound("Z").Error())
+			require.Equal(t, 1, simple.Edges())
+
+			multi := tt.newGraph(t, AllowParallelEdges(), AllowSelfLoops(), WithVertices([]string{"A", "B"}))
+			first, err := multi.AddEdgeWithID("A", "B")
+			require.NoError(t, err)
+			second, err := multi.AddEdgeWithID("A", "B")
+			require.NoError(t, err)
+			loop, err := multi.AddEdgeWithID("A", "A")
+			require.NoError(t, err)
+
+			require.Equal(t, 3, multi.Edges())
+			require.Equal(t, []Edge{
+				{ID: first, Source: "A", Target: "B", Weight: DefaultWeight},
+				{ID: second, Source: "A", Target: "B", Weight: DefaultWeight},
+				{ID: loop, Source: "A", Target: "A", Weight: DefaultWeight},
+			}, multi.ListEdges())
+			require.Len(t, multi.EdgesBetween("A", "B"), 2)
+
+			neighbors, err := multi.Neighbors("A")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"A", "B"}, neighbors)
+
+			// Deleting one of parallel edges keeps the vertices adjacent.
+			require.NoError(t, multi.DeleteEdgeByID(first))
+			require.True(t, multi.HasEdge("A", "B"))
+			require.Equal(t, 2, multi.Edges())
+			require.NoError(t, multi.DeleteEdgeByID(second))
+			require.False(t, multi.HasEdge("A", "B"))
+			require.EqualError(t, multi.DeleteEdgeByID(second), ErrEdgeIDNotFound(second).Error())
+
+			require.NoError(t, multi.DeleteEdge("A", "A"))
+			require.False(t, multi.HasEdge("A", "A"))
+			require.Zero(t, multi.Edges())
+		})
+	}
+}
+
+func TestGraphDeleteEdge(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t,
+				AllowParallelEdges(),
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "B"}, {"B", "C"}}),
+			)
+
+			require.NoError(t, g.DeleteEdge("A", "B"))
+			require.False(t, g.HasEdge("A", "B"))
+			require.False(t, g.HasEdge("B", "A"))
+			require.Equal(t, 1, g.Edges())
+			require.EqualError(t, g.DeleteEdge("A", "B"), ErrEdgeNotFound("A", "B").Error())
+
+			neighbors, err := g.Neighbors("B")
+			require.NoError(t, err)
+			require.Equal(t, []string{"C"}, neighbors)
+
+			if !g.IsDirected() {
+				// Undirected edges can be deleted in either direction.
+				require.NoError(t, g.DeleteEdge("C", "B"))
+				require.Zero(t, g.Edges())
+			}
+		})
+	}
+}
+
+func TestGraphDeleteVertexEdges(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t,
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "B"}, {"B", "B"}, {"C", "D"}}),
+			)
+
+			require.NoError(t, g.DeleteVertex("B"))
+			require.Equal(t, []string{"A", "C", "D"}, g.ListVertices())
+			edges := g.ListEdges()
+			require.Len(t, edges, 1)
+			require.Equal(t, "C", edges[0].Source)
+			require.Equal(t, "D", edges[0].Target)
+			require.True(t, g.HasEdge("C", "D"))
+
+			neighbors, err := g.Neighbors("C")
+			require.NoError(t, err)
+			require.Equal(t, []string{"D"}, neighbors)
+		})
+	}
+}
+
+func TestGraphMaxEdgesAndDensity(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		directed bool
+		opts     []GraphOption
+		maxEdges float64
+		density  float64
+	}{
+		{
+			name:     "undirected simple",
+			opts:     []GraphOption{WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}})},
+			maxEdges: 3,
+			density:  1.0 / 3,
+		},
+		{
+			name:     "directed simple",
+			directed: true,
+			opts:     []GraphOption{WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}})},
+			maxEdges: 6,
+			density:  1.0 / 6,
+		},
+		{
+			name: "undirected with self-loops",
+			opts: []GraphOption{
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "A"}}),
+			},
+			maxEdges: 6,
+			density:  2.0 / 6,
+		},
+		{
+			name:     "directed with self-loops",
+			directed: true,
+			opts: []GraphOption{
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "A"}}),
+			},
+			maxEdges: 9,
+			density:  2.0 / 9,
+		},
+		{
+			name: "multigraph",
+			opts: []GraphOption{
+				AllowParallelEdges(),
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "A"}, {"A", "B"}, {"B", "C"}}),
+			},
+			maxEdges: math.Inf(1),
+			density:  2.0 / 3,
+		},
+		{
+			name:     "single vertex",
+			opts:     []GraphOption{WithVertices([]string{"A"})},
+			maxEdges: 0,
+			density:  0,
+		},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					newGraph := repr.newGraph
+					if tt.directed {
+						newGraph = repr.newDirected
+					}
+					g := newGraph(t, tt.opts...)
+
+					require.Equal(t, tt.maxEdges, g.MaxEdges())
+					require.InDelta(t, tt.density, g.Density(), 1e-9)
+				})
+			}
+		})
+	}
+}
+
+func TestGraphAddWeightedEdge(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t, WithVertices([]string{"A", "B", "C"}))
+			weighted, err := g.AddWeightedEdge("A", "B", 2.5)
+			require.NoError(t, err)
+			unweighted, err := g.AddEdgeWithID("B", "C")
+			require.NoError(t, err)
+			_, err = g.AddWeightedEdge("A", "Z", 1)
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			require.Equal(t, []Edge{
+				{ID: weighted, Source: "A", Target: "B", Weight: 2.5},
+				{ID: unweighted, Source: "B", Target: "C", Weight: DefaultWeight},
+			}, g.ListEdges())
+		})
+	}
+}
+
+func TestWithWeightedEdges(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B"}),
+				WithWeightedEdges([]Edge{{ID: 42, Source: "A", Target: "B", Weight: -1}}),
+			)
+
+			require.Equal(t, []Edge{{ID: 0, Source: "A", Target: "B", Weight: -1}}, g.ListEdges())
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"sort"
+	"sync"
+)
+
+type EventType int
+
+const (
+	VertexAdded EventType = iota
+	VertexDeleted
+	EdgeAdded
+	EdgeDeleted
+)
+
+func (t EventType) String() string {
+	switch t {
+	case VertexAdded:
+		return "VertexAdded"
+	case VertexDeleted:
+		return "VertexDeleted"
+	case EdgeAdded:
+		return "EdgeAdded"
+	case EdgeDeleted:
+		return "EdgeDeleted"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event describes a mutation of the graph,
+// Vertex is set for vertex events and Edge for edge events.
+type Event struct {
+	Type   EventType
+	Vertex string
+	Edge   Edge
+}
+
+// notifier delivers mutation events of a graph representation to the listeners.
+//
+// Mutations record events while holding the write lock of the representation,
+// the events are delivered after the write lock is released, so listeners can read the graph,
+// but they must not mutate it. Delivery is serialized, so listeners receive the events
+// in the order the mutations were applied.
+type notifier struct {
+	// delivery serializes the delivery of events.
+	delivery sync.Mutex
+	// pending and weighted are guarded by the write lock of the representation.
+	pending []Event
+	// weighted is the number of edges with a weight other than DefaultWeight.
+	weighted int
+	// frozen is the persistent copy of the graph shared by its snapshots, nil until the first snapshot,
+	// then every recorded event updates it. It is guarded by the write lock of the representation,
+	// and by frozenMu while it is built under the read lock.
+	frozen   *frozenGraph
+	frozenMu sync.Mutex
+
+	mu        sync.RWMutex
+	nextID    int
+	listeners map[int]func(event Event)
+}
+
+// record saves the event to deliver it after the write lock is released,
+// must be called while holding the write lock.
+func (n *notifier) record(events ...Event) {
+	n.pending = append(n.pending, events...)
+
+	for _, event := range events {
+		if event.Edge.Weight == DefaultWeight {
+			continue
+		}
+		switch event.Type {
+		case EdgeAdded:
+			n.weighted++
+		case EdgeDeleted:
+			n.weighted--
+		}
+	}
+
+	if n.frozen != nil {
+		for _, event := range events {
+			n.frozen.apply(event)
+		}
+	}
+}
+
+// snapshot returns the persistent copy of the graph, which is built by the first call,
+// must be called while holding the read lock.
+func (n *notifier) snapshot(build func() *frozenGraph) frozenGraph {
+	n.frozenMu.Lock()
+	defer n.frozenMu.Unlock()
+
+	if n.frozen == nil {
+		n.frozen = build()
+	}
+	return *n.frozen
+}
+
+// recordDeletedEdges records deletion of the edges ordered by ID.
+func (n *notifier) recordDeletedEdges(edges map[int]Edge) {
+	ids := make([]int, 0, len(edges))
+	for id := range edges {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		n.record(Event{Type: EdgeDeleted, Edge: edges[id]})
+	}
+}
+
+// unlockAndNotify releases the write lock and delivers the recorded events.
+func (n *notifier) unlockAndNotify(lock *sync.RWMutex) {
+	events := n.pending
+	n.pending = nil
+
+	if len(events) == 0 {
+		lock.Unlock()
+		return
+	}
+
+	// Take the delivery lock before releasing the write lock,
+	// so that events of the next mutation can't be delivered earlier.
+	n.delivery.Lock()
+	defer n.delivery.Unlock()
+	lock.Unlock()
+
+	n.mu.RLock()
+	listeners := make([]func(event Event), 0, len(n.listeners))
+	ids := make([]int, 0, len(n.listeners))
+	for id := range n.listeners {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		listeners = append(listeners, n.listeners[id])
+	}
+	n.mu.RUnlock()
+
+	for _, event := range events {
+		for _, listener := range listeners {
+			listener(event)
+		}
+	}
+}
+
+// Subscribe registers the listener of mutation events and returns the function which unregisters it.
+func (n *notifier) Subscribe(listener func(event Event)) func() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.listeners == nil {
+		n.listeners = make(map[int]func(event Event))
+	}
+
+	id := n.nextID
+	n.nextID++
+	n.listeners[id] = listener
+
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		delete(n.listeners, id)
+	}
+}
@@ -2,7 +2,10 @@ package graph
 
 import (
 	"container/list"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 )
 
 var (
@@ -22,20 +25,69 @@ var (
 		return fmt.Errorf("edge \"%v\" -> \"%v\" already exists", source, target)
 	}
 
-	ErrCyclicCheckOnlyForDirected = "cyclic check applied only for directed"
+	ErrEdgeIDNotFound = func(id int) error {
+		return fmt.Errorf("edge with id %v not found", id)
+	}
+
+	ErrNotDirected = errors.New("operation applied only for directed graph")
+
+	ErrNotUndirected = errors.New("operation applied only for undirected graph")
+
+	ErrCyclic = errors.New("graph contains a cycle")
+
+	ErrDirectionMismatch = errors.New("graphs must be both directed or both undirected")
+
+	ErrFileDirectionMismatch = errors.New("file stores a graph of the other direction")
+
+	ErrFileCorrupted = errors.New("file contains a corrupted record")
+
+	ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+	ErrNotConnected = errors.New("graph is not connected")
+
+	ErrNegativeWeight = errors.New("graph contains an edge with negative weight")
+
+	ErrInvalidWalkParameter = errors.New("walk length and number of walks must not be negative, " +
+		"restart probability must be in [0, 1] and node2vec parameters must be positive")
+
+	ErrNegativeSampleSize = errors.New("sample size must not be negative")
+
+	ErrPathNotFound = func(source, target string) error {
+		return fmt.Errorf("path \"%v\" -> \"%v\" not found", source, target)
+	}
+
+	ErrSelfLoop = func(vertex string) error {
+		return fmt.Errorf("vertex \"%v\" has a self-loop", vertex)
+	}
 )
 
 type GraphRepr interface {
 	setDirected()
+	setEdgePolicy(policy edgePolicy)
+	edgePolicy() edgePolicy
 	IsDirected() bool
 	Vertices() int
 	Edges() int
 	HasVertex(vertex string) bool
+	ListVertices() []string
+	Neighbors(vertex string) ([]string, error)
 	AddVertex(vertex string) error
 	DeleteVertex(vertex string) error
 	HasEdge(source, target string) bool
 	AddEdge(source, target string) error
+	AddEdgeWithID(source, target string) (int, error)
+	AddWeightedEdge(source, target string, weight float64) (int, error)
 	DeleteEdge(source, target string) error
+	DeleteEdgeByID(id int) error
+	ListEdges() []Edge
+	restoreEdge(edge Edge) error
+	clone() GraphRepr
+	snapshot() frozenGraph
+	index(workers int) (*adjIndex, error)
+	indexWithEdges(workers int) (*adjIndex, []Edge, error)
+	arcs(vertex string, reverse bool) ([]arc, error)
+	unweighted() bool
+	Subscribe(listener func(event Event)) func()
 	BFS(start string, callback func(node string)) error
 	DFS(start string, callback func(node string)) error
 	IsCyclic() bool
@@ -61,41 +113,117 @@ func WithEdges(edges [][2]string) GraphOption {
 	}
 }
 
+// WithWeightedEdges adds the edges with their weights, IDs of the given edges are ignored.
+func WithWeightedEdges(edges []Edge) GraphOption {
+	return func(gr GraphRepr) {
+		for _, edge := range edges {
+			gr.AddWeightedEdge(edge.Source, edge.Target, edge.Weight)
+		}
+	}
+}
+
 type Graph struct {
-	repr GraphRepr
+	repr    GraphRepr
+	journal journal
 }
 
 func New(opts ...GraphOption) *Graph {
-	list := newAdjList(opts...)
-	return &Graph{list}
+	return newGraph(newAdjList(), opts...)
 }
 
 func NewDirected(opts ...GraphOption) *Graph {
-	list := newAdjList(opts...)
+	list := newAdjList()
 	list.setDirected()
-	return &Graph{list}
+	return newGraph(list, opts...)
 }
 
 func NewMatrix(opts ...GraphOption) *Graph {
-	matrix := newAdjMatrix(opts...)
-	return &Graph{matrix}
+	return newGraph(newAdjMatrix(), opts...)
 }
 
 func NewList(opts ...GraphOption) *Graph {
-	list := newAdjList(opts...)
-	return &Graph{list}
+	return newGraph(newAdjList(), opts...)
 }
 
 func NewDirectedMatrix(opts ...GraphOption) *Graph {
-	matrix := newAdjMatrix(opts...)
+	matrix := newAdjMatrix()
 	matrix.setDirected()
-	return &Graph{matrix}
+	return newGraph(matrix, opts...)
 }
 
 func NewDirectedList(opts ...GraphOption) *Graph {
-	list := newAdjList(opts...)
+	list := newAdjList()
 	list.setDirected()
-	return &Graph{list}
+	return newGraph(list, opts...)
+}
+
+// newGraph wraps the representation and applies options to it,
+// options are applied after the representation was set as directed
+// so that edges are added with the right direction.
+func newGraph(repr GraphRepr, opts ...GraphOption) *Graph {
+	for _, opt := range opts {
+		opt(repr)
+	}
+	return &Graph{repr: repr}
+}
+
+// newEmpty creates an empty graph with the same representation, direction and edge policy as the graph.
+func (g *Graph) newEmpty() *Graph {
+	var empty *Graph
+	switch g.repr.(type) {
+	case *adjMatrix:
+		if g.repr.IsDirected() {
+			empty = NewDirectedMatrix()
+		} else {
+			empty = NewMatrix()
+		}
+	default:
+		// File-backed graphs get an in-memory list
+		if g.repr.IsDirected() {
+			empty = NewDirectedList()
+		} else {
+			empty = NewList()
+		}
+	}
+
+	empty.repr.setEdgePolicy(g.repr.edgePolicy())
+	return empty
+}
+
+// Close closes the file of a graph opened with OpenFile or OpenDirectedFile,
+// it does nothing for in-memory graphs.
+func (g *Graph) Close() error {
+	if closer, ok := g.repr.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// batcher is implemented by the representations which can sync many mutations to the disk at once.
+type batcher interface {
+	beginBatch()
+	endBatch() error
+}
+
+// Batch calls fn and returns its error, a graph opened with OpenFile or OpenDirectedFile
+// syncs the mutations applied while fn runs to the disk once after fn returns, instead of once per mutation.
+// Until then the mutations are applied, but a power loss can lose them,
+// the mutations of other goroutines applied meanwhile are synced together with them.
+// Batch of an in-memory graph just calls fn.
+//
+// Returns the error of fn, or the error of the sync if fn succeeded.
+func (g *Graph) Batch(fn func(g *Graph) error) error {
+	b, ok := g.repr.(batcher)
+	if !ok {
+		return fn(g)
+	}
+
+	b.beginBatch()
+	err := fn(g)
+	if syncErr := b.endBatch(); err == nil {
+		err = syncErr
+	}
+	return err
 }
 
 func (g *Graph) Vertices() int {
@@ -106,24 +234,88 @@ func (g *Graph) Edges() int {
 	return g.repr.Edges()
 }
 
+// MaxEdges returns the maximum number of edges the graph can have under its edge policy,
+// self-loops add one edge per vertex, and if parallel edges are allowed there is no limit (+Inf).
+//
 // https://www.baeldung.com/cs/graphs-sparse-vs-dense
 func (g *Graph) MaxEdges() float64 {
+	if g.repr.edgePolicy().parallelEdges {
+		return math.Inf(1)
+	}
+
+	return g.maxSimpleEdges()
+}
+
+// maxSimpleEdges returns the maximum number of edges without parallel edges.
+func (g *Graph) maxSimpleEdges() float64 {
 	vertices := g.repr.Vertices()
+	policy := g.repr.edgePolicy()
 
 	if g.repr.IsDirected() {
+		if policy.selfLoops {
+			return float64(vertices * vertices)
+		}
 		return float64(vertices * (vertices - 1))
 	}
 
+	if policy.selfLoops {
+		return float64(vertices*(vertices+1)) / 2
+	}
 	return float64(vertices*(vertices-1)) / 2
 }
 
+// Density returns the ratio of the number of edges to the maximum number of edges.
+//
+// For multigraphs parallel edges are counted once,
+// i.e. the density is the ratio of the connected pairs of vertices.
+//
 // https://www.baeldung.com/cs/graphs-sparse-vs-dense
 func (g *Graph) Density() float64 {
+	maxEdges := g.maxSimpleEdges()
+	if maxEdges == 0 {
+		return 0
+	}
+
 	edges := g.repr.Edges()
-	maxEdges := g.MaxEdges()
+	if g.repr.edgePolicy().parallelEdges {
+		edges = g.connectedPairs()
+	}
+
 	return float64(edges) / maxEdges
 }
 
+// connectedPairs returns the number of edges with parallel edges counted once.
+func (g *Graph) connectedPairs() int {
+	directed := g.repr.IsDirected()
+	pairs := make(map[[2]string]bool)
+
+	for _, edge := range g.repr.ListEdges() {
+		pair := [2]string{edge.Source, edge.Target}
+		if !directed && pair[0] > pair[1] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		pairs[pair] = true
+	}
+
+	return len(pairs)
+}
+
+func (g *Graph) HasVertex(vertex string) bool {
+	return g.repr.HasVertex(vertex)
+}
+
+func (g *Graph) ListVertices() []string {
+	return g.repr.ListVertices()
+}
+
+func (g *Graph) Neighbors(vertex string) ([]string, error) {
+	return g.repr.Neighbors(vertex)
+}
+
+func (g *Graph) IsDirected() bool {
+	return g.repr.IsDirected()
+}
+
 func (g *Graph) HasEdge(source, target string) bool {
 	return g.repr.HasEdge(source, target)
 }
@@ -140,10 +332,44 @@ func (g *Graph) AddEdge(source, target string) error {
 	return g.repr.AddEdge(source, target)
 }
 
+// AddEdgeWithID adds the edge and returns its ID,
+// which can be used to delete the edge when there are parallel edges.
+func (g *Graph) AddEdgeWithID(source, target string) (int, error) {
+	return g.repr.AddEdgeWithID(source, target)
+}
+
+// AddWeightedEdge adds the edge with the given weight and returns its ID,
+// edges added without a weight have DefaultWeight.
+func (g *Graph) AddWeightedEdge(source, target string, weight float64) (int, error) {
+	return g.repr.AddWeightedEdge(source, target, weight)
+}
+
+// DeleteEdge removes all edges between source and target.
 func (g *Graph) DeleteEdge(source, target string) error {
 	return g.repr.DeleteEdge(source, target)
 }
 
+func (g *Graph) DeleteEdgeByID(id int) error {
+	return g.repr.DeleteEdgeByID(id)
+}
+
+// ListEdges returns all edges ordered by ID.
+func (g *Graph) ListEdges() []Edge {
+	return g.repr.ListEdges()
+}
+
+// EdgesBetween returns the edges connecting source with target ordered by ID,
+// for undirected graphs edges in both directions are returned.
+func (g *Graph) EdgesBetween(source, target string) []Edge {
+	var edges []Edge
+	for _, edge := range g.repr.ListEdges() {
+		if edge.connects(source, target, !g.repr.IsDirected()) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
 func (g *Graph) BFS(start string, callback func(node string)) error {
 	return g.repr.BFS(start, callback)
 }
@@ -152,6 +378,10 @@ func (g *Graph) DFS(start string, callback func(node string)) error {
 	return g.repr.DFS(start, callback)
 }
 
+// IsCyclic checks whether the graph has a cycle, following the direction of edges in directed graphs.
+//
+// In undirected graphs parallel edges and self-loops are cycles, an edge isn't a cycle by itself,
+// use FindUndirectedCycle to get the cycle.
 func (g *Graph) IsCyclic() bool {
 	return g.repr.IsCyclic()
 }
@@ -160,6 +390,16 @@ func (g *Graph) FindComponents() ([]*list.List, error) {
 	return g.repr.FindComponents()
 }
 
+// Subscribe registers the listener of vertex and edge additions and deletions
+// and returns the function which unregisters it.
+//
+// Deleting a vertex emits EdgeDeleted for each of its edges before VertexDeleted.
+// Listeners are called synchronously after the mutation is applied, in the order of mutations,
+// they can read the graph, but must not mutate it.
+func (g *Graph) Subscribe(listener func(event Event)) func() {
+	return g.repr.Subscribe(listener)
+}
+
 func (g *Graph) String() string {
 	return g.repr.String()
 }
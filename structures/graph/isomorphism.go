@@ -0,0 +1,355 @@
+package graph
+
+// Isomorphic checks whether two graphs are isomorphic using the VF2 algorithm
+// and returns the mapping of the vertices of g1 to the vertices of g2 if they are.
+//
+// Graphs can have different representations, but both must be directed or both undirected.
+//
+// https://doi.org/10.1109/TPAMI.2004.75
+//
+// Time complexity: O(v!*v) in the worst case, O(v^2) in the best case, where v is number of vertices
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func Isomorphic(g1, g2 *Graph) (map[string]string, bool, error) {
+	if g1.repr.IsDirected() != g2.repr.IsDirected() {
+		return nil, false, ErrDirectionMismatch
+	}
+
+	idx1, err := newAdjIndex(g1.repr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	idx2, err := newAdjIndex(g2.repr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m1, m2 := newVF2Graph(idx1), newVF2Graph(idx2)
+	if m1.len() != m2.len() || m1.edges() != m2.edges() {
+		return nil, false, nil
+	}
+
+	var mapping map[string]string
+	vf2 := newVF2(m1, m2, false, func(core1, core2 []int) bool {
+		mapping = make(map[string]string, len(core1))
+		for v, w := range core1 {
+			mapping[idx1.names[v]] = idx2.names[w]
+		}
+		return false
+	})
+	vf2.match()
+
+	return mapping, mapping != nil, nil
+}
+
+// SubgraphIsomorphisms finds all embeddings of the pattern into the target graph using
+// the VF2 algorithm and passes each of them to the callback as the mapping of
+// the vertices of the pattern to the vertices of the target.
+//
+// Embeddings are induced: two mapped vertices of the target are adjacent
+// if and only if the corresponding vertices of the pattern are adjacent.
+//
+// Graphs can have different representations, but both must be directed or both undirected.
+//
+// Time complexity: O(v!*v) in the worst case, where v is number of vertices of the target
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func SubgraphIsomorphisms(pattern, target *Graph, callback func(mapping map[string]string)) error {
+	if pattern.repr.IsDirected() != target.repr.IsDirected() {
+		return ErrDirectionMismatch
+	}
+
+	patternIdx, err := newAdjIndex(pattern.repr)
+	if err != nil {
+		return err
+	}
+
+	targetIdx, err := newAdjIndex(target.repr)
+	if err != nil {
+		return err
+	}
+
+	if patternIdx.len() == 0 || patternIdx.len() > targetIdx.len() {
+		return nil
+	}
+
+	vf2 := newVF2(newVF2Graph(targetIdx), newVF2Graph(patternIdx), true, func(core1, core2 []int) bool {
+		mapping := make(map[string]string, len(core2))
+		for v, w := range core2 {
+			mapping[patternIdx.names[v]] = targetIdx.names[w]
+		}
+		callback(mapping)
+		return true
+	})
+	vf2.match()
+
+	return nil
+}
+
+// vf2Graph is a graph prepared for the VF2 matching with O(1) adjacency checks.
+type vf2Graph struct {
+	succ []map[int]bool
+	pred []map[int]bool
+}
+
+func newVF2Graph(idx *adjIndex) *vf2Graph {
+	n := idx.len()
+	g := &vf2Graph{
+		succ: make([]map[int]bool, n),
+		pred: make([]map[int]bool, n),
+	}
+
+	for v := 0; v < n; v++ {
+		g.succ[v] = make(map[int]bool, len(idx.adj[v]))
+		for _, w := range idx.adj[v] {
+			g.succ[v][w] = true
+		}
+	}
+
+	if !idx.directed {
+		g.pred = g.succ
+		return g
+	}
+
+	for v := 0; v < n; v++ {
+		g.pred[v] = make(map[int]bool)
+	}
+	for v := 0; v < n; v++ {
+		for w := range g.succ[v] {
+			g.pred[w][v] = true
+		}
+	}
+
+	return g
+}
+
+func (g *vf2Graph) len() int {
+	return len(g.succ)
+}
+
+func (g *vf2Graph) edges() int {
+	edges := 0
+	for _, succ := range g.succ {
+		edges += len(succ)
+	}
+	return edges
+}
+
+// vf2 holds the state of the VF2 algorithm, which maps vertices of g2 into g1.
+type vf2 struct {
+	g1, g2   *vf2Graph
+	subgraph bool
+	// found receives the mappings core1: g1 -> g2 and core2: g2 -> g1,
+	// the search continues while it returns true.
+	found func(core1, core2 []int) bool
+
+	depth        int
+	core1, core2 []int
+	// Depth at which a vertex entered the terminal set of
+	// successors (out) or predecessors (in) of the mapped vertices, 0 - not entered.
+	in1, out1 []int
+	in2, out2 []int
+}
+
+func newVF2(g1, g2 *vf2Graph, subgraph bool, found func(core1, core2 []int) bool) *vf2 {
+	filled := func(n, value int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = value
+		}
+		return s
+	}
+
+	return &vf2{
+		g1:       g1,
+		g2:       g2,
+		subgraph: subgraph,
+		found:    found,
+		core1:    filled(g1.len(), -1),
+		core2:    filled(g2.len(), -1),
+		in1:      make([]int, g1.len()),
+		out1:     make([]int, g1.len()),
+		in2:      make([]int, g2.len()),
+		out2:     make([]int, g2.len()),
+	}
+}
+
+// match extends the current mapping, returns false when the search must stop.
+func (s *vf2) match() bool {
+	if s.depth == s.g2.len() {
+		return s.found(s.core1, s.core2)
+	}
+
+	v2, candidates := s.candidates()
+	for _, v1 := range candidates {
+		if !s.feasible(v1, v2) {
+			continue
+		}
+
+		s.add(v1, v2)
+		next := s.match()
+		s.restore(v1, v2)
+
+		if !next {
+			return false
+		}
+	}
+
+	return true
+}
+
+// candidates returns the next vertex of g2 to map and the vertices of g1 it can be mapped to.
+func (s *vf2) candidates() (int, []int) {
+	terminal := func(core, set []int) []int {
+		var result []int
+		for v := range core {
+			if core[v] == -1 && set[v] > 0 {
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+
+	if t1, t2 := terminal(s.core1, s.out1), terminal(s.core2, s.out2); len(t1) > 0 && len(t2) > 0 {
+		return t2[0], t1
+	}
+
+	if t1, t2 := terminal(s.core1, s.in1), terminal(s.core2, s.in2); len(t1) > 0 && len(t2) > 0 {
+		return t2[0], t1
+	}
+
+	v2 := -1
+	for v := range s.core2 {
+		if s.core2[v] == -1 {
+			v2 = v
+			break
+		}
+	}
+
+	var candidates []int
+	for v := range s.core1 {
+		if s.core1[v] == -1 {
+			candidates = append(candidates, v)
+		}
+	}
+
+	return v2, candidates
+}
+
+// feasible checks that the pair (v1, v2) can be added to the mapping.
+func (s *vf2) feasible(v1, v2 int) bool {
+	if s.g1.succ[v1][v1] != s.g2.succ[v2][v2] {
+		return false
+	}
+
+	// Edges to already mapped vertices must be preserved in both directions.
+	if !s.consistent(s.g1.succ[v1], s.g2.succ[v2], s.core1) ||
+		!s.consistent(s.g2.succ[v2], s.g1.succ[v1], s.core2) ||
+		!s.consistent(s.g1.pred[v1], s.g2.pred[v2], s.core1) ||
+		!s.consistent(s.g2.pred[v2], s.g1.pred[v1], s.core2) {
+		return false
+	}
+
+	// Look-ahead: compare the number of neighbors in the terminal sets and outside of them.
+	for _, pair := range [][2]map[int]bool{
+		{s.g1.succ[v1], s.g2.succ[v2]},
+		{s.g1.pred[v1], s.g2.pred[v2]},
+	} {
+		in1, out1, new1 := s.lookAhead(pair[0], s.core1, s.in1, s.out1)
+		in2, out2, new2 := s.lookAhead(pair[1], s.core2, s.in2, s.out2)
+
+		if s.subgraph {
+			if in1 < in2 || out1 < out2 || new1 < new2 {
+				return false
+			}
+		} else if in1 != in2 || out1 != out2 || new1 != new2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// consistent checks that every mapped vertex of from is mapped into a vertex of to.
+func (s *vf2) consistent(from, to map[int]bool, core []int) bool {
+	for w := range from {
+		if core[w] != -1 && !to[core[w]] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *vf2) lookAhead(neighbors map[int]bool, core, in, out []int) (int, int, int) {
+	var inCount, outCount, newCount int
+	for w := range neighbors {
+		if core[w] != -1 {
+			continue
+		}
+		if in[w] > 0 {
+			inCount++
+		}
+		if out[w] > 0 {
+			outCount++
+		}
+		if in[w] == 0 && out[w] == 0 {
+			newCount++
+		}
+	}
+	return inCount, outCount, newCount
+}
+
+func (s *vf2) add(v1, v2 int) {
+	s.depth++
+	s.core1[v1] = v2
+	s.core2[v2] = v1
+
+	s.enter(s.g1, v1, s.in1, s.out1)
+	s.enter(s.g2, v2, s.in2, s.out2)
+}
+
+func (s *vf2) enter(g *vf2Graph, v int, in, out []int) {
+	if in[v] == 0 {
+		in[v] = s.depth
+	}
+	if out[v] == 0 {
+		out[v] = s.depth
+	}
+	for w := range g.succ[v] {
+		if out[w] == 0 {
+			out[w] = s.depth
+		}
+	}
+	for w := range g.pred[v] {
+		if in[w] == 0 {
+			in[w] = s.depth
+		}
+	}
+}
+
+func (s *vf2) restore(v1, v2 int) {
+	s.leave(s.g1, v1, s.in1, s.out1)
+	s.leave(s.g2, v2, s.in2, s.out2)
+
+	s.core1[v1] = -1
+	s.core2[v2] = -1
+	s.depth--
+}
+
+func (s *vf2) leave(g *vf2Graph, v int, in, out []int) {
+	reset := func(set []int, w int) {
+		if set[w] == s.depth {
+			set[w] = 0
+		}
+	}
+
+	reset(in, v)
+	reset(out, v)
+	for w := range g.succ[v] {
+		reset(out, w)
+	}
+	for w := range g.pred[v] {
+		reset(in, w)
+	}
+}
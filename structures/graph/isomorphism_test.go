@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsomorphic(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		g1, g2 *Graph
+		want   bool
+	}{
+		{
+			name: "should match cycles with different labels and representations",
+			g1: New(
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "A"}}),
+			),
+			g2: NewMatrix(
+				WithVertices([]string{"1", "2", "3", "4"}),
+				WithEdges([][2]string{{"1", "3"}, {"3", "2"}, {"2", "4"}, {"4", "1"}}),
+			),
+			want: true,
+		},
+		{
+			name: "should not match path and star",
+			g1: New(
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}}),
+			),
+			g2: NewMatrix(
+				WithVertices([]string{"1", "2", "3", "4"}),
+				WithEdges([][2]string{{"1", "2"}, {"1", "3"}, {"1", "4"}}),
+			),
+			want: false,
+		},
+		{
+			name: "should respect edge direction",
+			g1: NewDirected(
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "C"}}),
+			),
+			g2: NewDirectedMatrix(
+				WithVertices([]string{"1", "2", "3"}),
+				WithEdges([][2]string{{"2", "1"}, {"3", "1"}}),
+			),
+			want: false,
+		},
+		{
+			name: "should match digraphs",
+			g1: NewDirected(
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"A", "C"}}),
+			),
+			g2: NewDirectedMatrix(
+				WithVertices([]string{"1", "2", "3"}),
+				WithEdges([][2]string{{"3", "2"}, {"2", "1"}, {"3", "1"}}),
+			),
+			want: true,
+		},
+		{
+			name: "should match self-loops",
+			g1: New(
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "A"}}),
+			),
+			g2: NewMatrix(
+				AllowSelfLoops(),
+				WithVertices([]string{"1", "2"}),
+				WithEdges([][2]string{{"1", "2"}, {"2", "2"}}),
+			),
+			want: true,
+		},
+		{
+			name: "should respect self-loops",
+			g1: New(
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"A", "A"}}),
+			),
+			g2: NewMatrix(
+				AllowSelfLoops(),
+				WithVertices([]string{"1", "2", "3"}),
+				WithEdges([][2]string{{"1", "2"}, {"2", "3"}, {"2", "2"}}),
+			),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mapping, ok, err := Isomorphic(tt.g1, tt.g2)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ok)
+			if !tt.want {
+				require.Nil(t, mapping)
+				return
+			}
+
+			require.Len(t, mapping, tt.g1.Vertices())
+			for _, u := range tt.g1.ListVertices() {
+				for _, v := range tt.g1.ListVertices() {
+					require.Equal(t, tt.g1.HasEdge(u, v), tt.g2.HasEdge(mapping[u], mapping[v]), "pair %v %v", u, v)
+				}
+			}
+		})
+	}
+}
+
+func TestIsomorphicDirectionMismatch(t *testing.T) {
+	t.Parallel()
+	_, _, err := Isomorphic(New(), NewDirected())
+	require.ErrorIs(t, err, ErrDirectionMismatch)
+
+	err = SubgraphIsomorphisms(New(), NewDirected(), func(mapping map[string]string) {})
+	require.ErrorIs(t, err, ErrDirectionMismatch)
+}
+
+func TestSubgraphIsomorphisms(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should find induced triangles", func(t *testing.T) {
+		t.Parallel()
+		pattern := NewMatrix(
+			WithVertices([]string{"x", "y", "z"}),
+			WithEdges([][2]string{{"x", "y"}, {"y", "z"}, {"z", "x"}}),
+		)
+		// Two triangles A-B-C and C-D-E sharing the vertex C.
+		target := New(
+			WithVertices([]string{"A", "B", "C", "D", "E"}),
+			WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}, {"C", "D"}, {"D", "E"}, {"E", "C"}}),
+		)
+
+		var got []map[string]string
+		err := SubgraphIsomorphisms(pattern, target, func(mapping map[string]string) {
+			got = append(got, mapping)
+		})
+
+		require.NoError(t, err)
+		// 3! automorphisms for each of 2 triangles.
+		require.Len(t, got, 12)
+	})
+
+	t.Run("should find only induced paths", func(t *testing.T) {
+		t.Parallel()
+		pattern := NewDirected(
+			WithVertices([]string{"x", "y", "z"}),
+			WithEdges([][2]string{{"x", "y"}, {"y", "z"}}),
+		)
+		target := NewDirectedMatrix(
+			WithVertices([]string{"A", "B", "C", "D"}),
+			WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"A", "C"}, {"C", "D"}}),
+		)
+
+		var got []map[string]string
+		err := SubgraphIsomorphisms(pattern, target, func(mapping map[string]string) {
+			got = append(got, mapping)
+		})
+
+		require.NoError(t, err)
+		// A -> B -> C isn't induced because of A -> C.
+		require.ElementsMatch(t, []map[string]string{
+			{"x": "A", "y": "C", "z": "D"},
+			{"x": "B", "y": "C", "z": "D"},
+		}, got)
+	})
+}
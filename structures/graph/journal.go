@@ -0,0 +1,120 @@
+package graph
+
+import "sync"
+
+// Checkpoint identifies a state of the graph which can be restored with Graph.Rollback.
+type Checkpoint int
+
+// journal records the mutations of the graph since the first checkpoint.
+type journal struct {
+	mu          sync.Mutex
+	unsubscribe func()
+	rollingBack bool
+	events      []Event
+	nextID      Checkpoint
+	// checkpoints maps a checkpoint to the number of events recorded before it.
+	checkpoints map[Checkpoint]int
+}
+
+func (j *journal) listen(event Event) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.rollingBack {
+		j.events = append(j.events, event)
+	}
+}
+
+// Checkpoint marks the current state of the graph, the graph starts to record
+// the change journal on the first checkpoint.
+func (g *Graph) Checkpoint() Checkpoint {
+	j := &g.journal
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.unsubscribe == nil {
+		j.unsubscribe = g.repr.Subscribe(j.listen)
+		j.checkpoints = make(map[Checkpoint]int)
+	}
+
+	cp := j.nextID
+	j.nextID++
+	j.checkpoints[cp] = len(j.events)
+
+	return cp
+}
+
+// Rollback undoes all mutations made after the checkpoint, checkpoints made after it are released.
+//
+// Vertices and edges are restored with their original names and edge IDs,
+// but restored vertices are moved to the end of the vertex order.
+//
+// Rollback must not run concurrently with other mutations of the graph.
+//
+// Time complexity: O(k), where k is number of undone mutations (multiplied by the cost of each of them)
+func (g *Graph) Rollback(cp Checkpoint) error {
+	j := &g.journal
+
+	j.mu.Lock()
+	pos, ok := j.checkpoints[cp]
+	if !ok {
+		j.mu.Unlock()
+		return ErrCheckpointNotFound
+	}
+	undo := append([]Event(nil), j.events[pos:]...)
+	j.rollingBack = true
+	j.mu.Unlock()
+
+	var err error
+	for i := len(undo) - 1; i >= 0 && err == nil; i-- {
+		err = g.undo(undo[i])
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.rollingBack = false
+	if err != nil {
+		return err
+	}
+
+	j.events = j.events[:pos]
+	for other := range j.checkpoints {
+		if other > cp {
+			delete(j.checkpoints, other)
+		}
+	}
+
+	return nil
+}
+
+// Release stops recording the change journal and releases all checkpoints.
+func (g *Graph) Release() {
+	j := &g.journal
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.unsubscribe != nil {
+		j.unsubscribe()
+	}
+	j.unsubscribe = nil
+	j.events = nil
+	j.checkpoints = nil
+}
+
+// undo applies the inverse of the event.
+func (g *Graph) undo(event Event) error {
+	switch event.Type {
+	case VertexAdded:
+		return g.repr.DeleteVertex(event.Vertex)
+	case VertexDeleted:
+		return g.repr.AddVertex(event.Vertex)
+	case EdgeAdded:
+		return g.repr.DeleteEdgeByID(event.Edge.ID)
+	case EdgeDeleted:
+		return g.repr.restoreEdge(event.Edge)
+	}
+	return nil
+}
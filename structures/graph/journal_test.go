@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphSubscribe(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t, WithVertices([]string{"A"}))
+
+			var events []Event
+			unsubscribe := g.Subscribe(func(event Event) {
+				// Listeners can read the graph.
+				require.GreaterOrEqual(t, g.Vertices(), 0)
+				events = append(events, event)
+			})
+
+			require.NoError(t, g.AddVertex("B"))
+			id, err := g.AddEdgeWithID("A", "B")
+			require.NoError(t, err)
+			require.Error(t, g.AddEdge("A", "B"))
+			require.NoError(t, g.DeleteVertex("A"))
+
+			unsubscribe()
+			require.NoError(t, g.AddVertex("C"))
+
+			edge := Edge{ID: id, Source: "A", Target: "B", Weight: DefaultWeight}
+			require.Equal(t, []Event{
+				{Type: VertexAdded, Vertex: "B"},
+				{Type: EdgeAdded, Edge: edge},
+				{Type: EdgeDeleted, Edge: edge},
+				{Type: VertexDeleted, Vertex: "A"},
+			}, events)
+		})
+	}
+}
+
+func TestGraphRollback(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}}),
+			)
+			before := g.ListEdges()
+
+			cp := g.Checkpoint()
+			require.NoError(t, g.DeleteVertex("B"))
+			require.NoError(t, g.AddVertex("D"))
+			require.NoError(t, g.AddEdge("A", "D"))
+
+			nested := g.Checkpoint()
+			require.NoError(t, g.AddEdge("C", "D"))
+			require.NoError(t, g.Rollback(nested))
+			require.False(t, g.HasEdge("C", "D"))
+			require.True(t, g.HasEdge("A", "D"))
+
+			require.NoError(t, g.Rollback(cp))
+			require.ElementsMatch(t, []string{"A", "B", "C"}, g.ListVertices())
+			require.Equal(t, before, g.ListEdges())
+			require.True(t, g.HasEdge("A", "B"))
+			require.True(t, g.HasEdge("B", "C"))
+
+			// Checkpoints made after the restored one are released.
+			require.ErrorIs(t, g.Rollback(nested), ErrCheckpointNotFound)
+			// The restored checkpoint can be used again.
+			require.NoError(t, g.AddVertex("E"))
+			require.NoError(t, g.Rollback(cp))
+			require.False(t, g.HasVertex("E"))
+
+			g.Release()
+			require.ErrorIs(t, g.Rollback(cp), ErrCheckpointNotFound)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package graph
+
+import "math"
+
+// hopDistances returns the number of edges on the shortest path from the source to every vertex,
+// -1 for unreachable vertices.
+func (idx *adjIndex) hopDistances(source int) []int {
+	dist := make([]int, idx.len())
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[source] = 0
+
+	queue := []int{source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range idx.adj[u] {
+			if dist[v] == -1 {
+				dist[v] = dist[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return dist
+}
+
+// eccentricity returns the largest hop distance from the vertex,
+// ErrNotConnected if some vertex is unreachable.
+func (idx *adjIndex) eccentricity(v int) (float64, error) {
+	ecc := 0
+	for _, d := range idx.hopDistances(v) {
+		if d == -1 {
+			return 0, ErrNotConnected
+		}
+		if d > ecc {
+			ecc = d
+		}
+	}
+	return float64(ecc), nil
+}
+
+func (g *Graph) eccentricities() (*adjIndex, []float64, error) {
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ecc := make([]float64, idx.len())
+	for v := range ecc {
+		if ecc[v], err = idx.eccentricity(v); err != nil {
+			return nil, nil, err
+		}
+	}
+	return idx, ecc, nil
+}
+
+// Eccentricity returns the largest number of edges on a shortest path from the vertex to another vertex.
+// Distances are hop counts, edge weights are ignored.
+//
+// For directed graphs the paths follow the direction of edges.
+//
+// Returns ErrNotConnected if some vertex isn't reachable from the vertex.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Eccentricity(vertex string) (float64, error) {
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return 0, err
+	}
+
+	v, ok := idx.index[vertex]
+	if !ok {
+		return 0, ErrVertexNotFound(vertex)
+	}
+
+	return idx.eccentricity(v)
+}
+
+// Diameter returns the largest eccentricity of vertices, i.e. the largest hop distance between two vertices,
+// 0 for the empty graph.
+//
+// Returns ErrNotConnected if the graph isn't (strongly) connected.
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Diameter() (float64, error) {
+	_, ecc, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	diameter := 0.0
+	for _, e := range ecc {
+		diameter = math.Max(diameter, e)
+	}
+	return diameter, nil
+}
+
+// Radius returns the smallest eccentricity of vertices, 0 for the empty graph.
+//
+// Returns ErrNotConnected if the graph isn't (strongly) connected.
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Radius() (float64, error) {
+	_, ecc, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ecc) == 0 {
+		return 0, nil
+	}
+
+	radius := ecc[0]
+	for _, e := range ecc {
+		radius = math.Min(radius, e)
+	}
+	return radius, nil
+}
+
+// Center returns the vertices with eccentricity equal to the radius in the order they were added to the graph.
+//
+// Returns ErrNotConnected if the graph isn't (strongly) connected.
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Center() ([]string, error) {
+	return g.verticesByEccentricity(math.Min)
+}
+
+// Periphery returns the vertices with eccentricity equal to the diameter in the order they were added to the graph.
+//
+// Returns ErrNotConnected if the graph isn't (strongly) connected.
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) Periphery() ([]string, error) {
+	return g.verticesByEccentricity(math.Max)
+}
+
+// verticesByEccentricity returns the vertices with the eccentricity selected by pick.
+func (g *Graph) verticesByEccentricity(pick func(a, b float64) float64) ([]string, error) {
+	idx, ecc, err := g.eccentricities()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ecc) == 0 {
+		return []string{}, nil
+	}
+
+	target := ecc[0]
+	for _, e := range ecc {
+		target = pick(target, e)
+	}
+
+	vertices := make([]string, 0)
+	for v, e := range ecc {
+		if e == target {
+			vertices = append(vertices, idx.names[v])
+		}
+	}
+	return vertices, nil
+}
+
+// simpleNeighbors returns the neighbors of every vertex without self-loops.
+func (idx *adjIndex) simpleNeighbors() [][]int {
+	neighbors := make([][]int, idx.len())
+	for v, adj := range idx.adj {
+		neighbors[v] = make([]int, 0, len(adj))
+		for _, w := range adj {
+			if w != v {
+				neighbors[v] = append(neighbors[v], w)
+			}
+		}
+	}
+	return neighbors
+}
+
+// triangles returns the number of triangles every vertex belongs to.
+func (idx *adjIndex) triangles() []int {
+	neighbors := idx.simpleNeighbors()
+	marked := make([]bool, idx.len())
+	triangles := make([]int, idx.len())
+
+	for v := range neighbors {
+		triangles[v] = trianglesOf(v, neighbors, marked)
+	}
+
+	return triangles
+}
+
+// trianglesOf returns the number of triangles the vertex belongs to
+// by checking the adjacency among its neighbors only, marked must be all false and is left so.
+func trianglesOf(v int, neighbors [][]int, marked []bool) int {
+	for _, w := range neighbors[v] {
+		marked[w] = true
+	}
+
+	// Every triangle v-w-x is found twice: from w and from x.
+	closed := 0
+	for _, w := range neighbors[v] {
+		for _, x := range neighbors[w] {
+			if marked[x] {
+				closed++
+			}
+		}
+	}
+
+	for _, w := range neighbors[v] {
+		marked[w] = false
+	}
+
+	return closed / 2
+}
+
+func (g *Graph) undirectedIndex() (*adjIndex, error) {
+	if g.repr.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+	return newAdjIndex(g.repr)
+}
+
+// TriangleCount returns the number of triangles of the undirected graph,
+// self-loops and parallel edges are ignored.
+//
+// Time complexity: O(v*d^2), where v is number of vertices, and d is the maximum degree
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) TriangleCount() (int, error) {
+	idx, err := g.undirectedIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, t := range idx.triangles() {
+		total += t
+	}
+	return total / 3, nil
+}
+
+// LocalClusteringCoefficient returns the fraction of pairs of neighbors of the vertex which are adjacent,
+// 0 if the vertex has less than two neighbors. Self-loops and parallel edges are ignored.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) LocalClusteringCoefficient(vertex string) (float64, error) {
+	idx, err := g.undirectedIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	v, ok := idx.index[vertex]
+	if !ok {
+		return 0, ErrVertexNotFound(vertex)
+	}
+
+	neighbors := idx.simpleNeighbors()
+	degree := len(neighbors[v])
+	if degree < 2 {
+		return 0, nil
+	}
+
+	triangles := trianglesOf(v, neighbors, make([]bool, idx.len()))
+	return float64(triangles) / float64(degree*(degree-1)/2), nil
+}
+
+// GlobalClusteringCoefficient returns the transitivity of the undirected graph: the fraction of connected triples
+// of vertices which are closed into triangles, 0 if there are no connected triples.
+// Self-loops and parallel edges are ignored.
+//
+// Time complexity: O(v*d^2), where v is number of vertices, and d is the maximum degree
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) GlobalClusteringCoefficient() (float64, error) {
+	idx, err := g.undirectedIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	triples := 0
+	for _, adj := range idx.simpleNeighbors() {
+		triples += len(adj) * (len(adj) - 1) / 2
+	}
+	if triples == 0 {
+		return 0, nil
+	}
+
+	closed := 0
+	for _, t := range idx.triangles() {
+		closed += t
+	}
+
+	// Every triangle closes three triples, one centered at each of its vertices.
+	return float64(closed) / float64(triples), nil
+}
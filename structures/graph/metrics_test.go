@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphDistanceMetrics(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			// Path A-B-C-D with a pendant E attached to B.
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D", "E"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"B", "E"}}),
+			)
+
+			ecc, err := g.Eccentricity("A")
+			require.NoError(t, err)
+			require.Equal(t, 3.0, ecc)
+
+			diameter, err := g.Diameter()
+			require.NoError(t, err)
+			require.Equal(t, 3.0, diameter)
+
+			radius, err := g.Radius()
+			require.NoError(t, err)
+			require.Equal(t, 2.0, radius)
+
+			center, err := g.Center()
+			require.NoError(t, err)
+			require.Equal(t, []string{"B", "C"}, center)
+
+			periphery, err := g.Periphery()
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "D", "E"}, periphery)
+
+			_, err = g.Eccentricity("Z")
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+		})
+	}
+}
+
+func TestGraphDistanceMetricsDisconnected(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t, WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}}))
+
+			_, err := g.Eccentricity("A")
+			require.ErrorIs(t, err, ErrNotConnected)
+
+			ecc, err := g.Eccentricity("C")
+			require.ErrorIs(t, err, ErrNotConnected)
+			require.Zero(t, ecc)
+
+			_, err = g.Diameter()
+			require.ErrorIs(t, err, ErrNotConnected)
+
+			_, err = g.Radius()
+			require.ErrorIs(t, err, ErrNotConnected)
+
+			_, err = g.Center()
+			require.ErrorIs(t, err, ErrNotConnected)
+
+			_, err = g.Periphery()
+			require.ErrorIs(t, err, ErrNotConnected)
+
+			empty := repr.newGraph(t)
+			diameter, err := empty.Diameter()
+			require.NoError(t, err)
+			require.Zero(t, diameter)
+			center, err := empty.Center()
+			require.NoError(t, err)
+			require.Empty(t, center)
+		})
+	}
+}
+
+func TestGraphDirectedEccentricity(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}}),
+			)
+
+			diameter, err := g.Diameter()
+			require.NoError(t, err)
+			require.Equal(t, 2.0, diameter)
+
+			center, err := g.Center()
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "B", "C"}, center)
+		})
+	}
+}
+
+func TestGraphClustering(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			// Two triangles A-B-C and B-C-D sharing the edge B-C, and a pendant E.
+			g := repr.newGraph(t,
+				AllowParallelEdges(),
+				AllowSelfLoops(),
+				WithVertices([]string{"A", "B", "C", "D", "E"}),
+				WithEdges([][2]string{
+					{"A", "B"}, {"A", "C"}, {"B", "C"}, {"B", "D"}, {"C", "D"}, {"D", "E"},
+					{"C", "B"}, {"E", "E"},
+				}),
+			)
+
+			triangles, err := g.TriangleCount()
+			require.NoError(t, err)
+			require.Equal(t, 2, triangles)
+
+			tests := map[string]float64{"A": 1, "B": 2.0 / 3, "C": 2.0 / 3, "D": 1.0 / 3, "E": 0}
+			for vertex, want := range tests {
+				got, err := g.LocalClusteringCoefficient(vertex)
+				require.NoError(t, err)
+				require.InDelta(t, want, got, 1e-9, vertex)
+			}
+
+			// 6 closed triples of 1 + 3 + 3 + 3 + 0 triples.
+			global, err := g.GlobalClusteringCoefficient()
+			require.NoError(t, err)
+			require.InDelta(t, 6.0/10, global, 1e-9)
+
+			_, err = g.LocalClusteringCoefficient("Z")
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			_, err = repr.newDirected(t).TriangleCount()
+			require.ErrorIs(t, err, ErrNotUndirected)
+
+			global, err = repr.newGraph(t, WithVertices([]string{"A"})).GlobalClusteringCoefficient()
+			require.NoError(t, err)
+			require.Zero(t, global)
+		})
+	}
+}
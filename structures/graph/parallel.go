@@ -0,0 +1,215 @@
+package graph
+
+import (
+	"container/list"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelBFS traverses the graph level by level from the start vertex,
+// expanding each level with the given number of workers (GOMAXPROCS if workers <= 0).
+//
+// Vertices are passed to the callback from a single goroutine in exactly the same order as BFS does:
+// a vertex is discovered by the first vertex of the previous level adjacent to it.
+//
+// The graph is indexed by the same workers under a single read lock, so the traversal sees a consistent view
+// of the graph. Building the index takes most of the time, so ParallelBFS is faster than BFS
+// only on large graphs with several cores available (see BenchmarkParallelBFS).
+//
+// Time complexity: O((v+e)/w + v + l), where v is number of vertices, e is number of edges,
+// w is number of workers and l is number of levels
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) ParallelBFS(start string, workers int, callback func(vertex string)) error {
+	workers = workerCount(workers)
+
+	idx, err := g.repr.index(workers)
+	if err != nil {
+		return err
+	}
+
+	s, ok := idx.index[start]
+	if !ok {
+		return ErrVertexNotFound(start)
+	}
+
+	// owner[w] is the position in the BFS order of the vertex which discovered w,
+	// the smallest position wins, so the order matches the sequential BFS.
+	owner := make([]int64, idx.len())
+	for i := range owner {
+		owner[i] = math.MaxInt64
+	}
+	owner[s] = -1
+
+	frontier := []int{s}
+	// position of the first vertex of the frontier in the BFS order
+	offset := 0
+
+	for len(frontier) > 0 {
+		for _, v := range frontier {
+			callback(idx.names[v])
+		}
+
+		parallelFor(len(frontier), workers, func(lo, hi, _ int) {
+			for p := lo; p < hi; p++ {
+				pos := int64(offset + p)
+				for _, w := range idx.adj[frontier[p]] {
+					for {
+						curr := atomic.LoadInt64(&owner[w])
+						if pos >= curr || atomic.CompareAndSwapInt64(&owner[w], curr, pos) {
+							break
+						}
+					}
+				}
+			}
+		})
+
+		chunks := make([][]int, workers)
+		parallelFor(len(frontier), workers, func(lo, hi, chunk int) {
+			for p := lo; p < hi; p++ {
+				pos := int64(offset + p)
+				for _, w := range idx.adj[frontier[p]] {
+					if owner[w] == pos {
+						chunks[chunk] = append(chunks[chunk], w)
+					}
+				}
+			}
+		})
+
+		offset += len(frontier)
+		frontier = frontier[:0:0]
+		for _, chunk := range chunks {
+			frontier = append(frontier, chunk...)
+		}
+	}
+
+	return nil
+}
+
+// ParallelFindComponents finds the connected components with a concurrent union-find
+// over the edges split between the given number of workers (GOMAXPROCS if workers <= 0).
+//
+// For undirected graphs the components are the same as found by FindComponents up to ordering,
+// for directed graphs the weakly connected components are found.
+// Unlike FindComponents, the order is deterministic: components are ordered by their first vertex,
+// vertices of a component keep the order they were added to the graph.
+//
+// Like ParallelBFS, the graph is indexed by the workers under a single read lock (see BenchmarkParallelFindComponents).
+//
+// Time complexity: O((v+e)*α(v)/w + v), where v is number of vertices, e is number of edges,
+// w is number of workers and α is the inverse Ackermann function
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) ParallelFindComponents(workers int) ([]*list.List, error) {
+	workers = workerCount(workers)
+
+	idx, err := g.repr.index(workers)
+	if err != nil {
+		return nil, err
+	}
+
+	n := idx.len()
+	uf := newConcurrentUnionFind(n)
+
+	parallelFor(n, workers, func(lo, hi, _ int) {
+		for v := lo; v < hi; v++ {
+			for _, w := range idx.adj[v] {
+				uf.union(v, w)
+			}
+		}
+	})
+
+	components := make([]*list.List, 0)
+	componentOf := make(map[int]*list.List)
+	for v := 0; v < n; v++ {
+		root := uf.find(v)
+		component, ok := componentOf[root]
+		if !ok {
+			component = list.New()
+			componentOf[root] = component
+			components = append(components, component)
+		}
+		component.PushBack(idx.names[v])
+	}
+
+	return components, nil
+}
+
+// concurrentUnionFind is a lock-free disjoint set, a root always has the smallest index
+// of its set, so concurrent unions can't create cycles.
+type concurrentUnionFind struct {
+	parent []int64
+}
+
+func newConcurrentUnionFind(n int) *concurrentUnionFind {
+	parent := make([]int64, n)
+	for i := range parent {
+		parent[i] = int64(i)
+	}
+	return &concurrentUnionFind{parent}
+}
+
+func (uf *concurrentUnionFind) find(v int) int {
+	x := int64(v)
+	for {
+		p := atomic.LoadInt64(&uf.parent[x])
+		if p == x {
+			return int(x)
+		}
+
+		// Path halving, losing the race only means the path stays longer.
+		gp := atomic.LoadInt64(&uf.parent[p])
+		atomic.CompareAndSwapInt64(&uf.parent[x], p, gp)
+		x = gp
+	}
+}
+
+func (uf *concurrentUnionFind) union(a, b int) {
+	for {
+		ra, rb := uf.find(a), uf.find(b)
+		if ra == rb {
+			return
+		}
+		if ra < rb {
+			ra, rb = rb, ra
+		}
+		// Link the larger root under the smaller one,
+		// retry if ra stopped being a root in the meantime.
+		if atomic.CompareAndSwapInt64(&uf.parent[ra], int64(ra), int64(rb)) {
+			return
+		}
+	}
+}
+
+func workerCount(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
+
+// parallelFor splits [0, n) into contiguous chunks, one per worker,
+// and runs fn for each of them concurrently.
+func parallelFor(n, workers int, fn func(lo, hi, chunk int)) {
+	size := (n + workers - 1) / workers
+	if size == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for chunk := 0; chunk*size < n; chunk++ {
+		lo, hi := chunk*size, (chunk+1)*size
+		if hi > n {
+			hi = n
+		}
+
+		wg.Add(1)
+		go func(lo, hi, chunk int) {
+			defer wg.Done()
+			fn(lo, hi, chunk)
+		}(lo, hi, chunk)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func BenchmarkParallelBFS(b *testing.B) {
+	sizes := []int{1000, 100000}
+	for _, size := range sizes {
+		g := createRandomGraph(b, New, size, 8*size, 1)
+
+		b.Run(fmt.Sprintf("BFS-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.BFS("0", func(string) {})
+			}
+		})
+
+		for _, workers := range []int{1, 4} {
+			b.Run(fmt.Sprintf("ParallelBFS-%d-workers-%d", size, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					g.ParallelBFS("0", workers, func(string) {})
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkParallelFindComponents(b *testing.B) {
+	sizes := []int{1000, 100000}
+	for _, size := range sizes {
+		g := createRandomGraph(b, New, size, size/2, 1)
+
+		b.Run(fmt.Sprintf("FindComponents-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.FindComponents()
+			}
+		})
+
+		for _, workers := range []int{1, 4} {
+			b.Run(fmt.Sprintf("ParallelFindComponents-%d-workers-%d", size, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					g.ParallelFindComponents(workers)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"container/list"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomGraph(t testing.TB, newGraph func(opts ...GraphOption) *Graph, vertices, edges int, seed int64) *Graph {
+	t.Helper()
+	rnd := rand.New(rand.NewSource(seed))
+
+	g := newGraph()
+	for i := 0; i < vertices; i++ {
+		require.NoError(t, g.AddVertex(fmt.Sprint(i)))
+	}
+	for i := 0; i < edges; i++ {
+		source, target := rnd.Intn(vertices), rnd.Intn(vertices)
+		// Duplicates and self-loops are rejected by the default policy.
+		_ = g.AddEdge(fmt.Sprint(source), fmt.Sprint(target))
+	}
+	return g
+}
+
+func TestGraphParallelBFS(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := createRandomGraph(t, tt.newGraph.bind(t), 300, 600, 42)
+
+			var want []string
+			require.NoError(t, g.BFS("0", func(vertex string) {
+				want = append(want, vertex)
+			}))
+
+			for _, workers := range []int{0, 1, 3, 8} {
+				var got []string
+				require.NoError(t, g.ParallelBFS("0", workers, func(vertex string) {
+					got = append(got, vertex)
+				}))
+				require.Equal(t, want, got, "workers %v", workers)
+			}
+
+			err := g.ParallelBFS("unknown", 2, func(vertex string) {})
+			require.EqualError(t, err, ErrVertexNotFound("unknown").Error())
+		})
+	}
+}
+
+func TestGraphParallelFindComponents(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := createRandomGraph(t, repr.newGraph.bind(t), 400, 300, 7)
+
+			want, err := g.FindComponents()
+			require.NoError(t, err)
+
+			for _, workers := range []int{0, 1, 4} {
+				got, err := g.ParallelFindComponents(workers)
+				require.NoError(t, err)
+				require.ElementsMatch(t, componentSets(want), componentSets(got), "workers %v", workers)
+			}
+
+			t.Run("weakly connected components of digraph", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newDirected(t,
+					WithVertices([]string{"A", "B", "C", "D", "E"}),
+					WithEdges([][2]string{{"B", "A"}, {"C", "A"}, {"E", "D"}}),
+				)
+
+				got, err := g.ParallelFindComponents(2)
+				require.NoError(t, err)
+				require.Equal(t, [][]string{{"A", "B", "C"}, {"D", "E"}}, componentSlices(got))
+			})
+		})
+	}
+}
+
+// componentSlices converts components into slices keeping the order.
+func componentSlices(components []*list.List) [][]string {
+	result := make([][]string, 0, len(components))
+	for _, component := range components {
+		vertices := make([]string, 0, component.Len())
+		for e := component.Front(); e != nil; e = e.Next() {
+			vertices = append(vertices, e.Value.(string))
+		}
+		result = append(result, vertices)
+	}
+	return result
+}
+
+// componentSets converts components into sorted slices to compare them regardless of the order.
+func componentSets(components []*list.List) [][]string {
+	result := componentSlices(components)
+	for _, vertices := range result {
+		sort.Strings(vertices)
+	}
+	return result
+}
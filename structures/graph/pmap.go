@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"math/rand"
+
+	"golang.org/x/exp/constraints"
+)
+
+// pmap is a persistent ordered map: updates return a new map and leave the old one unchanged.
+//
+// It is a treap with random priorities, an update copies only the nodes on the path from the root
+// to the updated key, so the new map shares all other nodes with the old one.
+// The zero value is an empty map.
+type pmap[K constraints.Ordered, V any] struct {
+	root *pnode[K, V]
+	size int
+}
+
+type pnode[K constraints.Ordered, V any] struct {
+	key         K
+	value       V
+	priority    uint64
+	left, right *pnode[K, V]
+}
+
+func (m pmap[K, V]) len() int {
+	return m.size
+}
+
+// Time complexity: O(log(n)) on average, where n is number of keys
+//
+// Space complexity: O(1)
+func (m pmap[K, V]) get(key K) (V, bool) {
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// set returns the map with the key set to the value.
+//
+// Time complexity: O(log(n)) on average, where n is number of keys
+//
+// Space complexity: O(log(n)) on average, where n is number of keys
+func (m pmap[K, V]) set(key K, value V) pmap[K, V] {
+	root, added := m.root.insert(key, value, rand.Uint64())
+	if added {
+		return pmap[K, V]{root: root, size: m.size + 1}
+	}
+	return pmap[K, V]{root: root, size: m.size}
+}
+
+// delete returns the map without the key.
+//
+// Time complexity: O(log(n)) on average, where n is number of keys
+//
+// Space complexity: O(log(n)) on average, where n is number of keys
+func (m pmap[K, V]) delete(key K) pmap[K, V] {
+	root, deleted := m.root.remove(key)
+	if !deleted {
+		return m
+	}
+	return pmap[K, V]{root: root, size: m.size - 1}
+}
+
+// each calls fn for the keys in ascending order until fn returns false.
+//
+// Time complexity: O(n), where n is number of keys
+//
+// Space complexity: O(log(n)) on average, where n is number of keys
+func (m pmap[K, V]) each(fn func(key K, value V) bool) {
+	m.root.each(fn)
+}
+
+// insert returns a copy of the subtree with the key set to the value,
+// and whether the key was added rather than updated.
+func (n *pnode[K, V]) insert(key K, value V, priority uint64) (*pnode[K, V], bool) {
+	if n == nil {
+		return &pnode[K, V]{key: key, value: value, priority: priority}, true
+	}
+
+	c := *n
+	switch {
+	case key < n.key:
+		left, added := n.left.insert(key, value, priority)
+		c.left = left
+		// The copied child isn't shared yet, so it can be rotated in place.
+		if left.priority > c.priority {
+			c.left = left.right
+			left.right = &c
+			return left, added
+		}
+		return &c, added
+	case key > n.key:
+		right, added := n.right.insert(key, value, priority)
+		c.right = right
+		if right.priority > c.priority {
+			c.right = right.left
+			right.left = &c
+			return right, added
+		}
+		return &c, added
+	default:
+		c.value = value
+		return &c, false
+	}
+}
+
+// remove returns a copy of the subtree without the key, and whether the key was found.
+func (n *pnode[K, V]) remove(key K) (*pnode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	switch {
+	case key < n.key:
+		left, deleted := n.left.remove(key)
+		if !deleted {
+			return n, false
+		}
+		c := *n
+		c.left = left
+		return &c, true
+	case key > n.key:
+		right, deleted := n.right.remove(key)
+		if !deleted {
+			return n, false
+		}
+		c := *n
+		c.right = right
+		return &c, true
+	default:
+		return mergeNodes(n.left, n.right), true
+	}
+}
+
+// mergeNodes joins two subtrees, all keys of a are less than the keys of b.
+func mergeNodes[K constraints.Ordered, V any](a, b *pnode[K, V]) *pnode[K, V] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if a.priority > b.priority {
+		c := *a
+		c.right = mergeNodes(a.right, b)
+		return &c
+	}
+	c := *b
+	c.left = mergeNodes(a, b.left)
+	return &c
+}
+
+func (n *pnode[K, V]) each(fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.each(fn) && fn(n.key, n.value) && n.right.each(fn)
+}
@@ -0,0 +1,43 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentMap(t *testing.T) {
+	t.Parallel()
+	keys := func(m pmap[int, string]) []int {
+		var keys []int
+		m.each(func(key int, _ string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		return keys
+	}
+
+	var empty pmap[int, string]
+	m := empty
+	for _, key := range []int{5, 3, 8, 1, 4, 7, 9, 2, 6} {
+		m = m.set(key, "v")
+	}
+	require.Equal(t, 0, empty.len())
+	require.Equal(t, 9, m.len())
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, keys(m))
+
+	updated := m.set(4, "w").delete(1).delete(10)
+	value, ok := updated.get(4)
+	require.True(t, ok)
+	require.Equal(t, "w", value)
+	require.Equal(t, []int{2, 3, 4, 5, 6, 7, 8, 9}, keys(updated))
+
+	// The old map is unchanged.
+	value, ok = m.get(4)
+	require.True(t, ok)
+	require.Equal(t, "v", value)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, keys(m))
+
+	_, ok = updated.get(1)
+	require.False(t, ok)
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// graphFactory creates an empty graph, graphs backed by a file are stored in the temporary directory of the test.
+type graphFactory func(t *testing.T, opts ...GraphOption) *Graph
+
+// bind returns the factory with the test bound to it, in the shape of the constructors like New.
+func (f graphFactory) bind(t *testing.T) func(opts ...GraphOption) *Graph {
+	return func(opts ...GraphOption) *Graph {
+		t.Helper()
+		return f(t, opts...)
+	}
+}
+
+// representation is a way to store graphs, with the factories of its undirected and directed graphs.
+type representation struct {
+	name        string
+	newGraph    graphFactory
+	newDirected graphFactory
+}
+
+// representations returns every representation of graphs, tests run their cases against each of them.
+func representations() []representation {
+	return []representation{
+		{
+			name:        "list",
+			newGraph:    func(t *testing.T, opts ...GraphOption) *Graph { t.Helper(); return NewList(opts...) },
+			newDirected: func(t *testing.T, opts ...GraphOption) *Graph { t.Helper(); return NewDirectedList(opts...) },
+		},
+		{
+			name:        "matrix",
+			newGraph:    func(t *testing.T, opts ...GraphOption) *Graph { t.Helper(); return NewMatrix(opts...) },
+			newDirected: func(t *testing.T, opts ...GraphOption) *Graph { t.Helper(); return NewDirectedMatrix(opts...) },
+		},
+		{
+			name:     "file",
+			newGraph: func(t *testing.T, opts ...GraphOption) *Graph { t.Helper(); return openTempFile(t, OpenFile, opts...) },
+			newDirected: func(t *testing.T, opts ...GraphOption) *Graph {
+				t.Helper()
+				return openTempFile(t, OpenDirectedFile, opts...)
+			},
+		},
+	}
+}
+
+// namedFactory is the factory of either undirected or directed graphs of a representation.
+type namedFactory struct {
+	name     string
+	directed bool
+	newGraph graphFactory
+}
+
+// factories returns the factories of both undirected and directed graphs of every representation,
+// tests whose cases hold for graphs of any direction run them against each of the factories.
+func factories() []namedFactory {
+	var factories []namedFactory
+	for _, repr := range representations() {
+		factories = append(factories,
+			namedFactory{name: repr.name, newGraph: repr.newGraph},
+			namedFactory{name: "directed " + repr.name, directed: true, newGraph: repr.newDirected},
+		)
+	}
+	return factories
+}
+
+func TestGraphRepresentations(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.newGraph(t, AllowParallelEdges(), WithVertices([]string{"A", "B", "C", "D"}))
+			require.Equal(t, tt.directed, g.IsDirected())
+
+			var events []Event
+			g.Subscribe(func(event Event) {
+				events = append(events, event)
+			})
+
+			first, err := g.AddEdgeWithID("A", "B")
+			require.NoError(t, err)
+			second, err := g.AddWeightedEdge("A", "B", 2)
+			require.NoError(t, err)
+			require.NoError(t, g.AddEdge("B", "C"))
+			require.EqualError(t, g.AddEdge("A", "A"), ErrSelfLoop("A").Error())
+			require.EqualError(t, g.AddEdge("A", "Z"), ErrVertexNotFound("Z").Error())
+
+			require.Equal(t, 3, g.Edges())
+			require.Equal(t, !tt.directed, g.HasEdge("B", "A"))
+			neighbors, err := g.Neighbors("A")
+			require.NoError(t, err)
+			require.Equal(t, []string{"B"}, neighbors)
+
+			var visited []string
+			require.NoError(t, g.BFS("A", func(vertex string) {
+				visited = append(visited, vertex)
+			}))
+			require.Equal(t, []string{"A", "B", "C"}, visited)
+
+			// The snapshot keeps the state it was taken at.
+			snapshot := g.Snapshot()
+			require.NoError(t, g.DeleteEdgeByID(first))
+			require.True(t, g.HasEdge("A", "B"))
+			require.NoError(t, g.DeleteVertex("B"))
+			require.Equal(t, []string{"A", "C", "D"}, g.ListVertices())
+			require.Zero(t, g.Edges())
+			require.Equal(t, []Edge{
+				{ID: first, Source: "A", Target: "B", Weight: DefaultWeight},
+				{ID: second, Source: "A", Target: "B", Weight: 2},
+				{ID: second + 1, Source: "B", Target: "C", Weight: DefaultWeight},
+			}, snapshot.ListEdges())
+
+			require.Equal(t, []Event{
+				{Type: EdgeAdded, Edge: Edge{ID: first, Source: "A", Target: "B", Weight: DefaultWeight}},
+				{Type: EdgeAdded, Edge: Edge{ID: second, Source: "A", Target: "B", Weight: 2}},
+				{Type: EdgeAdded, Edge: Edge{ID: second + 1, Source: "B", Target: "C", Weight: DefaultWeight}},
+				{Type: EdgeDeleted, Edge: Edge{ID: first, Source: "A", Target: "B", Weight: DefaultWeight}},
+				{Type: EdgeDeleted, Edge: Edge{ID: second, Source: "A", Target: "B", Weight: 2}},
+				{Type: EdgeDeleted, Edge: Edge{ID: second + 1, Source: "B", Target: "C", Weight: DefaultWeight}},
+				{Type: VertexDeleted, Vertex: "B"},
+			}, events)
+
+			// Derived graphs keep the direction and the edge policy.
+			// Graphs derived from file-backed graphs are kept in memory.
+			empty := g.newEmpty()
+			if _, ok := g.repr.(*adjMatrix); ok {
+				require.IsType(t, &adjMatrix{}, empty.repr)
+			} else {
+				require.IsType(t, &adjList{}, empty.repr)
+			}
+			require.Equal(t, tt.directed, empty.IsDirected())
+			require.Equal(t, g.repr.edgePolicy(), empty.repr.edgePolicy())
+		})
+	}
+}
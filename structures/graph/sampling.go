@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// SampleVertices returns the subgraph induced by n vertices chosen uniformly at random,
+// or a copy of the graph if it has at most n vertices.
+//
+// The sample keeps the representation, the edge policy, the order of vertices and the IDs and weights of edges,
+// the same seed produces the same sample of the same graph.
+//
+// Returns ErrNegativeSampleSize if n is negative.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) SampleVertices(n int, seed int64) (*Graph, error) {
+	if n < 0 {
+		return nil, ErrNegativeSampleSize
+	}
+
+	vertices := g.repr.ListVertices()
+	rng := rand.New(rand.NewSource(seed))
+
+	chosen := make(map[string]bool, n)
+	for _, i := range sampleIndices(rng, len(vertices), n) {
+		chosen[vertices[i]] = true
+	}
+
+	return g.subgraph(chosen, func(edge Edge) bool {
+		return chosen[edge.Source] && chosen[edge.Target]
+	})
+}
+
+// SampleEdges returns the subgraph of n edges chosen uniformly at random and their endpoints,
+// or a copy of the graph without isolated vertices if it has at most n edges.
+//
+// The sample keeps the representation, the edge policy, the order of vertices and the IDs and weights of edges,
+// the same seed produces the same sample of the same graph.
+//
+// Returns ErrNegativeSampleSize if n is negative.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) SampleEdges(n int, seed int64) (*Graph, error) {
+	if n < 0 {
+		return nil, ErrNegativeSampleSize
+	}
+
+	edges := g.repr.ListEdges()
+	rng := rand.New(rand.NewSource(seed))
+
+	vertices := make(map[string]bool)
+	chosen := make(map[int]bool, n)
+	for _, i := range sampleIndices(rng, len(edges), n) {
+		edge := edges[i]
+		chosen[edge.ID] = true
+		vertices[edge.Source] = true
+		vertices[edge.Target] = true
+	}
+
+	return g.subgraph(vertices, func(edge Edge) bool {
+		return chosen[edge.ID]
+	})
+}
+
+// SnowballSample returns the subgraph induced by the seed vertices and the given number of waves:
+// each wave adds up to k random neighbors of every vertex added by the previous wave (all neighbors if k <= 0).
+//
+// The sample keeps the representation, the edge policy, the order of vertices and the IDs and weights of edges,
+// the same seed produces the same sample of the same graph.
+//
+// Returns ErrNegativeSampleSize if waves is negative.
+//
+// Time complexity: O(v+e), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) SnowballSample(seeds []string, waves, k int, seed int64) (*Graph, error) {
+	if waves < 0 {
+		return nil, ErrNegativeSampleSize
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	chosen := make(map[string]bool)
+	wave := make([]int, 0, len(seeds))
+
+	for _, vertex := range seeds {
+		v, ok := idx.index[vertex]
+		if !ok {
+			return nil, ErrVertexNotFound(vertex)
+		}
+		if !chosen[vertex] {
+			chosen[vertex] = true
+			wave = append(wave, v)
+		}
+	}
+
+	for ; waves > 0 && len(wave) > 0; waves-- {
+		var next []int
+		for _, v := range wave {
+			neighbors := idx.adj[v]
+			picked := rng.Perm(len(neighbors))
+			if k > 0 && k < len(picked) {
+				picked = picked[:k]
+			}
+
+			for _, i := range picked {
+				w := neighbors[i]
+				if !chosen[idx.names[w]] {
+					chosen[idx.names[w]] = true
+					next = append(next, w)
+				}
+			}
+		}
+		wave = next
+	}
+
+	return g.subgraph(chosen, func(edge Edge) bool {
+		return chosen[edge.Source] && chosen[edge.Target]
+	})
+}
+
+// sampleIndices returns n distinct indices of [0, size) chosen uniformly at random, in increasing order.
+func sampleIndices(rng *rand.Rand, size, n int) []int {
+	perm := rng.Perm(size)
+	if n < size {
+		perm = perm[:n]
+	}
+	sort.Ints(perm)
+	return perm
+}
+
+// subgraph returns the graph with the given vertices and the edges accepted by keepEdge,
+// it keeps the representation, the edge policy, the order of vertices and the IDs and weights of edges.
+func (g *Graph) subgraph(vertices map[string]bool, keepEdge func(edge Edge) bool) (*Graph, error) {
+	sub := g.newEmpty()
+
+	for _, vertex := range g.repr.ListVertices() {
+		if vertices[vertex] {
+			if err := sub.repr.AddVertex(vertex); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, edge := range g.repr.ListEdges() {
+		if keepEdge(edge) {
+			if err := sub.repr.restoreEdge(edge); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sub, nil
+}
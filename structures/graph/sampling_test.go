@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphSampleVertices(t *testing.T) {
+	t.Parallel()
+	for _, tt := range factories() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := createRandomGraph(t, tt.newGraph.bind(t), 30, 80, 1)
+
+			sample, err := g.SampleVertices(10, 2)
+			require.NoError(t, err)
+			require.Equal(t, 10, sample.Vertices())
+			require.Equal(t, g.IsDirected(), sample.IsDirected())
+
+			vertices := sample.ListVertices()
+			for _, u := range vertices {
+				require.True(t, g.HasVertex(u))
+				for _, v := range vertices {
+					require.Equal(t, g.HasEdge(u, v), sample.HasEdge(u, v))
+				}
+			}
+
+			same, err := g.SampleVertices(10, 2)
+			require.NoError(t, err)
+			require.Equal(t, sample.ListEdges(), same.ListEdges())
+
+			all, err := g.SampleVertices(100, 2)
+			require.NoError(t, err)
+			require.Equal(t, g.ListVertices(), all.ListVertices())
+			require.Equal(t, g.ListEdges(), all.ListEdges())
+
+			_, err = g.SampleVertices(-1, 2)
+			require.ErrorIs(t, err, ErrNegativeSampleSize)
+		})
+	}
+}
+
+func TestGraphSampleEdges(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				AllowParallelEdges(),
+				WithVertices([]string{"A", "B", "C", "D", "E"}),
+				WithWeightedEdges([]Edge{
+					{Source: "A", Target: "B", Weight: 2},
+					{Source: "A", Target: "B", Weight: 3},
+					{Source: "B", Target: "C", Weight: 4},
+					{Source: "C", Target: "D", Weight: 5},
+				}),
+			)
+
+			sample, err := g.SampleEdges(2, 3)
+			require.NoError(t, err)
+			require.Equal(t, 2, sample.Edges())
+			require.Subset(t, g.ListEdges(), sample.ListEdges())
+			require.NotContains(t, sample.ListVertices(), "E")
+			endpoints := make(map[string]bool)
+			for _, edge := range sample.ListEdges() {
+				endpoints[edge.Source] = true
+				endpoints[edge.Target] = true
+			}
+			require.Len(t, endpoints, sample.Vertices())
+
+			_, err = g.SampleEdges(-1, 3)
+			require.ErrorIs(t, err, ErrNegativeSampleSize)
+		})
+	}
+}
+
+func TestGraphSnowballSample(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D", "E", "F"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}, {"D", "E"}, {"E", "F"}}),
+			)
+
+			sample, err := g.SnowballSample([]string{"A"}, 2, 0, 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "B", "C", "D"}, sample.ListVertices())
+			require.Equal(t, 4, sample.Edges())
+
+			sample, err = g.SnowballSample([]string{"A"}, 1, 1, 1)
+			require.NoError(t, err)
+			require.Equal(t, 2, sample.Vertices())
+			require.True(t, sample.HasVertex("A"))
+
+			sample, err = g.SnowballSample([]string{"A", "F"}, 0, 0, 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "F"}, sample.ListVertices())
+
+			_, err = g.SnowballSample([]string{"Z"}, 1, 0, 1)
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			_, err = g.SnowballSample([]string{"A"}, -1, 0, 1)
+			require.ErrorIs(t, err, ErrNegativeSampleSize)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dkhrunov/dsa-go/structures/heap"
+	"github.com/dkhrunov/dsa-go/utils"
+)
+
+// Path is a path of the graph with its total weight.
+type Path struct {
+	Vertices []string
+	Cost     float64
+}
+
+// queueItem is an item of the priority queue of Dijkstra's algorithm.
+type queueItem struct {
+	dist   float64
+	vertex int
+}
+
+func newDistanceQueue() *heap.Heap[queueItem] {
+	return heap.NewWithComparator(func(a, b queueItem) int8 {
+		return utils.LessComparator(a.dist, b.dist)
+	})
+}
+
+// newWeightedIndex builds the index with the lightest weight of parallel edges,
+// returns ErrNegativeWeight if there is an edge with negative weight.
+func newWeightedIndex(gr GraphRepr) (*adjIndex, error) {
+	idx, edges, err := newAdjIndexWithEdges(gr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range edges {
+		if edge.Weight < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+	idx.loadWeights(edges, math.Min)
+
+	return idx, nil
+}
+
+// dijkstra finds the shortest path from source to target over the weighted index
+// ignoring the excluded vertices and edges, so the graph itself isn't mutated.
+//
+// Returns nil if target isn't reachable.
+func (idx *adjIndex) dijkstra(source, target int, excludedVertices []bool, excludedEdges map[[2]int]bool) ([]int, float64) {
+	dist := make([]float64, idx.len())
+	prev := make([]int, idx.len())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	dist[source] = 0
+
+	queue := newDistanceQueue()
+	queue.Insert(queueItem{dist: 0, vertex: source})
+	for !queue.IsEmpty() {
+		item, _ := queue.Pop()
+		u := item.vertex
+		if item.dist > dist[u] {
+			continue
+		}
+		if u == target {
+			break
+		}
+
+		for k, v := range idx.adj[u] {
+			if excludedVertices[v] || excludedEdges[[2]int{u, v}] {
+				continue
+			}
+
+			if alt := dist[u] + idx.weights[u][k]; alt < dist[v] {
+				dist[v] = alt
+				prev[v] = u
+				queue.Insert(queueItem{dist: alt, vertex: v})
+			}
+		}
+	}
+
+	if math.IsInf(dist[target], 1) {
+		return nil, 0
+	}
+
+	var path []int
+	for v := target; v != -1; v = prev[v] {
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, dist[target]
+}
+
+// weight returns the weight of the edge u -> v.
+func (idx *adjIndex) weight(u, v int) float64 {
+	for k, w := range idx.adj[u] {
+		if w == v {
+			return idx.weights[u][k]
+		}
+	}
+	return math.Inf(1)
+}
+
+// KShortestPaths returns up to k loopless paths from source to target ordered by cost (Yen's algorithm),
+// paths of equal cost are ordered by the number of edges.
+//
+// Edges added without a weight have DefaultWeight, of parallel edges the lightest one is used.
+// Returns ErrNegativeWeight if the graph has an edge with negative weight
+// and no paths if target isn't reachable from source.
+//
+// Time complexity: O(k*v*(v+e)*log(v)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(k*v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) KShortestPaths(source, target string, k int) ([]Path, error) {
+	idx, err := newWeightedIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := idx.index[source]
+	if !ok {
+		return nil, ErrVertexNotFound(source)
+	}
+	t, ok := idx.index[target]
+	if !ok {
+		return nil, ErrVertexNotFound(target)
+	}
+
+	paths := make([]Path, 0, k)
+	if k <= 0 {
+		return paths, nil
+	}
+
+	excludedVertices := make([]bool, idx.len())
+	shortest, cost := idx.dijkstra(s, t, excludedVertices, nil)
+	if shortest == nil {
+		return paths, nil
+	}
+
+	found := [][]int{shortest}
+	costs := []float64{cost}
+
+	type candidate struct {
+		path []int
+		cost float64
+	}
+	var candidates []candidate
+	seen := map[string]bool{fmt.Sprint(shortest): true}
+
+	for len(found) < k {
+		last := found[len(found)-1]
+
+		rootCost := 0.0
+		for i := 0; i < len(last)-1; i++ {
+			spur := last[i]
+			root := last[:i+1]
+
+			// Forbid the next edges of the found paths sharing the root,
+			// and the root vertices, so that the spur path is loopless.
+			excludedEdges := make(map[[2]int]bool)
+			for _, path := range found {
+				if len(path) > i+1 && equalPaths(path[:i+1], root) {
+					excludedEdges[[2]int{path[i], path[i+1]}] = true
+				}
+			}
+			for _, v := range root[:i] {
+				excludedVertices[v] = true
+			}
+
+			spurPath, spurCost := idx.dijkstra(spur, t, excludedVertices, excludedEdges)
+
+			for _, v := range root[:i] {
+				excludedVertices[v] = false
+			}
+
+			if spurPath != nil {
+				path := append(append([]int(nil), root[:i]...), spurPath...)
+				if key := fmt.Sprint(path); !seen[key] {
+					seen[key] = true
+					candidates = append(candidates, candidate{path: path, cost: rootCost + spurCost})
+				}
+			}
+
+			rootCost += idx.weight(last[i], last[i+1])
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		best := 0
+		for i, c := range candidates {
+			if c.cost < candidates[best].cost ||
+				(c.cost == candidates[best].cost && len(c.path) < len(candidates[best].path)) {
+				best = i
+			}
+		}
+
+		found = append(found, candidates[best].path)
+		costs = append(costs, candidates[best].cost)
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+
+	for i, path := range found {
+		paths = append(paths, Path{Vertices: idx.toNames(path), Cost: costs[i]})
+	}
+
+	return paths, nil
+}
+
+func equalPaths(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
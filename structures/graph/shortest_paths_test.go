@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphKShortestPaths(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			t.Run("directed", func(t *testing.T) {
+				t.Parallel()
+				// Example from https://en.wikipedia.org/wiki/Yen%27s_algorithm
+				g := repr.newDirected(t,
+					WithVertices([]string{"C", "D", "E", "F", "G", "H"}),
+					WithWeightedEdges([]Edge{
+						{Source: "C", Target: "D", Weight: 3},
+						{Source: "C", Target: "E", Weight: 2},
+						{Source: "D", Target: "F", Weight: 4},
+						{Source: "E", Target: "D", Weight: 1},
+						{Source: "E", Target: "F", Weight: 2},
+						{Source: "E", Target: "G", Weight: 3},
+						{Source: "F", Target: "G", Weight: 2},
+						{Source: "F", Target: "H", Weight: 1},
+						{Source: "G", Target: "H", Weight: 2},
+					}),
+				)
+
+				paths, err := g.KShortestPaths("C", "H", 3)
+				require.NoError(t, err)
+				require.Equal(t, []Path{
+					{Vertices: []string{"C", "E", "F", "H"}, Cost: 5},
+					{Vertices: []string{"C", "E", "G", "H"}, Cost: 7},
+					{Vertices: []string{"C", "D", "F", "H"}, Cost: 8},
+				}, paths)
+
+				all, err := g.KShortestPaths("C", "H", 100)
+				require.NoError(t, err)
+				require.Len(t, all, 7)
+				for i := 1; i < len(all); i++ {
+					require.LessOrEqual(t, all[i-1].Cost, all[i].Cost)
+				}
+
+				none, err := g.KShortestPaths("H", "C", 3)
+				require.NoError(t, err)
+				require.Empty(t, none)
+
+				// The graph isn't mutated.
+				require.Equal(t, 9, g.Edges())
+			})
+
+			t.Run("undirected", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t,
+					AllowParallelEdges(),
+					WithVertices([]string{"A", "B", "C", "D"}),
+					WithEdges([][2]string{{"A", "B"}, {"B", "D"}, {"A", "C"}, {"C", "D"}, {"B", "C"}}),
+				)
+				_, err := g.AddWeightedEdge("D", "A", 3)
+				require.NoError(t, err)
+				_, err = g.AddWeightedEdge("D", "A", 5)
+				require.NoError(t, err)
+
+				paths, err := g.KShortestPaths("A", "D", 5)
+				require.NoError(t, err)
+				require.Equal(t, []Path{
+					{Vertices: []string{"A", "B", "D"}, Cost: 2},
+					{Vertices: []string{"A", "C", "D"}, Cost: 2},
+					{Vertices: []string{"A", "D"}, Cost: 3},
+					{Vertices: []string{"A", "B", "C", "D"}, Cost: 3},
+					{Vertices: []string{"A", "C", "B", "D"}, Cost: 3},
+				}, paths)
+			})
+		})
+	}
+}
+
+func TestGraphKShortestPathsErrors(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t, WithVertices([]string{"A", "B"}))
+
+			_, err := g.KShortestPaths("A", "Z", 1)
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			paths, err := g.KShortestPaths("A", "A", 3)
+			require.NoError(t, err)
+			require.Equal(t, []Path{{Vertices: []string{"A"}, Cost: 0}}, paths)
+
+			_, err = g.AddWeightedEdge("A", "B", -1)
+			require.NoError(t, err)
+			_, err = g.KShortestPaths("A", "B", 1)
+			require.ErrorIs(t, err, ErrNegativeWeight)
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package graph
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+)
+
+// Snapshot is an immutable view of the graph at the moment it was taken.
+//
+// Reading a snapshot doesn't block writers of the graph and isn't affected by later mutations,
+// so it is suitable for long traversals while the graph keeps changing.
+//
+// Snapshots share the structure with the graph: the graph keeps a persistent copy of itself,
+// which every mutation updates by copying only the changed paths, see Graph.Snapshot.
+// Neighbors of a vertex are ordered by the IDs of the edges connecting them,
+// vertices keep the order they were added to the graph.
+type Snapshot struct {
+	state frozenGraph
+}
+
+// frozenGraph is the persistent copy of a graph, copying it is O(1)
+// and updates of the copy don't change the original.
+type frozenGraph struct {
+	directed bool
+	vertices pmap[string, frozenVertex]
+	// order maps the sequence number of a vertex to its name, so vertices are listed in insertion order.
+	order   pmap[uint64, string]
+	edges   pmap[int, Edge]
+	nextSeq uint64
+}
+
+type frozenVertex struct {
+	seq uint64
+	// out holds the edges leaving the vertex of a directed graph,
+	// or incident to the vertex of an undirected graph, by ID.
+	out pmap[int, Edge]
+}
+
+// freeze builds the persistent copy of a graph from its vertices and its edges.
+//
+// Time complexity: O((v+e)*log(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func freeze(directed bool, vertices []string, edges []Edge) *frozenGraph {
+	g := &frozenGraph{directed: directed}
+	for _, vertex := range vertices {
+		g.apply(Event{Type: VertexAdded, Vertex: vertex})
+	}
+	for _, edge := range edges {
+		g.apply(Event{Type: EdgeAdded, Edge: edge})
+	}
+	return g
+}
+
+// apply updates the copy with the mutation event,
+// deletion of a vertex is preceded by the deletion of its edges.
+//
+// Time complexity: O(log(v+e)) on average, where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(log(v+e)) on average, where v is number of vertices, and e is number of edges
+func (g *frozenGraph) apply(event Event) {
+	switch event.Type {
+	case VertexAdded:
+		g.vertices = g.vertices.set(event.Vertex, frozenVertex{seq: g.nextSeq})
+		g.order = g.order.set(g.nextSeq, event.Vertex)
+		g.nextSeq++
+	case VertexDeleted:
+		if v, ok := g.vertices.get(event.Vertex); ok {
+			g.order = g.order.delete(v.seq)
+			g.vertices = g.vertices.delete(event.Vertex)
+		}
+	case EdgeAdded:
+		g.edges = g.edges.set(event.Edge.ID, event.Edge)
+		g.updateIncidence(event.Edge, func(out pmap[int, Edge]) pmap[int, Edge] {
+			return out.set(event.Edge.ID, event.Edge)
+		})
+	case EdgeDeleted:
+		g.edges = g.edges.delete(event.Edge.ID)
+		g.updateIncidence(event.Edge, func(out pmap[int, Edge]) pmap[int, Edge] {
+			return out.delete(event.Edge.ID)
+		})
+	}
+}
+
+// updateIncidence updates the edges of the source of the edge, and of its target in undirected graphs.
+func (g *frozenGraph) updateIncidence(edge Edge, update func(out pmap[int, Edge]) pmap[int, Edge]) {
+	endpoints := []string{edge.Source}
+	if !g.directed && edge.Target != edge.Source {
+		endpoints = append(endpoints, edge.Target)
+	}
+
+	for _, name := range endpoints {
+		if v, ok := g.vertices.get(name); ok {
+			v.out = update(v.out)
+			g.vertices = g.vertices.set(name, v)
+		}
+	}
+}
+
+// neighbors calls fn for the vertices connected to the vertex by its edges ordered by ID,
+// a vertex connected by parallel edges is passed once per edge.
+func (g *frozenGraph) neighbors(v frozenVertex, vertex string, fn func(neighbor string)) {
+	v.out.each(func(_ int, edge Edge) bool {
+		if !g.directed && edge.Target == vertex {
+			fn(edge.Source)
+		} else {
+			fn(edge.Target)
+		}
+		return true
+	})
+}
+
+// Snapshot returns an immutable consistent view of the graph.
+//
+// The graph keeps a persistent copy of itself, built by the first snapshot and then updated by every mutation,
+// so taking a snapshot only copies the root of the persistent copy under the read lock of the graph.
+// Mutations of a graph with snapshots cost an additional O(log(v+e)) time and space,
+// the structure they replace stays alive while the snapshots referencing it are.
+//
+// Time complexity: O(1), the first snapshot of the graph is O((v+e)*log(v+e)) (O(v^2+e*log(e)) for the adjacency matrix),
+// where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(1), the first snapshot of the graph is O(v+e)
+func (g *Graph) Snapshot() *Snapshot {
+	return &Snapshot{state: g.repr.snapshot()}
+}
+
+func (s *Snapshot) IsDirected() bool {
+	return s.state.directed
+}
+
+func (s *Snapshot) Vertices() int {
+	return s.state.vertices.len()
+}
+
+func (s *Snapshot) Edges() int {
+	return s.state.edges.len()
+}
+
+func (s *Snapshot) HasVertex(vertex string) bool {
+	_, ok := s.state.vertices.get(vertex)
+	return ok
+}
+
+// ListVertices returns the vertices in the order they were added to the graph.
+//
+// Time complexity: O(v), where v is number of vertices
+//
+// Space complexity: O(v), where v is number of vertices
+func (s *Snapshot) ListVertices() []string {
+	vertices := make([]string, 0, s.state.order.len())
+	s.state.order.each(func(_ uint64, vertex string) bool {
+		vertices = append(vertices, vertex)
+		return true
+	})
+	return vertices
+}
+
+// Neighbors returns the distinct vertices adjacent to the given vertex.
+//
+// Time complexity: O(d+log(v)), where d is degree of the vertex, and v is number of vertices
+//
+// Space complexity: O(d), where d is degree of the vertex
+func (s *Snapshot) Neighbors(vertex string) ([]string, error) {
+	v, ok := s.state.vertices.get(vertex)
+	if !ok {
+		return nil, ErrVertexNotFound(vertex)
+	}
+
+	seen := make(map[string]bool, v.out.len())
+	neighbors := make([]string, 0, v.out.len())
+	s.state.neighbors(v, vertex, func(neighbor string) {
+		if !seen[neighbor] {
+			seen[neighbor] = true
+			neighbors = append(neighbors, neighbor)
+		}
+	})
+
+	return neighbors, nil
+}
+
+// Time complexity: O(d+log(v)), where d is degree of the source, and v is number of vertices
+//
+// Space complexity: O(1)
+func (s *Snapshot) HasEdge(source, target string) bool {
+	v, ok := s.state.vertices.get(source)
+	if !ok {
+		return false
+	}
+
+	found := false
+	s.state.neighbors(v, source, func(neighbor string) {
+		found = found || neighbor == target
+	})
+	return found
+}
+
+// ListEdges returns all edges ordered by ID.
+//
+// Time complexity: O(e), where e is number of edges
+//
+// Space complexity: O(e), where e is number of edges
+func (s *Snapshot) ListEdges() []Edge {
+	edges := make([]Edge, 0, s.state.edges.len())
+	s.state.edges.each(func(_ int, edge Edge) bool {
+		edges = append(edges, edge)
+		return true
+	})
+	return edges
+}
+
+// Time complexity: O((v+e)*log(v)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (s *Snapshot) BFS(start string, callback func(node string)) error {
+	if !s.HasVertex(start) {
+		return ErrVertexNotFound(start)
+	}
+
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		callback(curr)
+
+		v, _ := s.state.vertices.get(curr)
+		s.state.neighbors(v, curr, func(neighbor string) {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		})
+	}
+
+	return nil
+}
+
+// Time complexity: O((v+e)*log(v)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (s *Snapshot) DFS(start string, callback func(node string)) error {
+	if !s.HasVertex(start) {
+		return ErrVertexNotFound(start)
+	}
+
+	s.dfs(start, callback, make(map[string]bool))
+	return nil
+}
+
+func (s *Snapshot) dfs(vertex string, callback func(node string), visited map[string]bool) {
+	visited[vertex] = true
+	callback(vertex)
+
+	v, _ := s.state.vertices.get(vertex)
+	s.state.neighbors(v, vertex, func(neighbor string) {
+		if !visited[neighbor] {
+			s.dfs(neighbor, callback, visited)
+		}
+	})
+}
+
+// IsCyclic checks whether the snapshot has a cycle, with the same rules as Graph.IsCyclic.
+//
+// Time complexity: O((v+e)*log(v)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (s *Snapshot) IsCyclic() bool {
+	if !s.state.directed {
+		edges := make(map[int]Edge, s.state.edges.len())
+		s.state.edges.each(func(id int, edge Edge) bool {
+			edges[id] = edge
+			return true
+		})
+		return hasUndirectedCycle(edges)
+	}
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, s.state.vertices.len())
+
+	var visit func(vertex string, v frozenVertex) bool
+	visit = func(vertex string, v frozenVertex) bool {
+		state[vertex] = inProgress
+		cyclic := false
+		s.state.neighbors(v, vertex, func(neighbor string) {
+			if cyclic {
+				return
+			}
+			switch state[neighbor] {
+			case inProgress:
+				cyclic = true
+			case unvisited:
+				next, _ := s.state.vertices.get(neighbor)
+				cyclic = visit(neighbor, next)
+			}
+		})
+		state[vertex] = done
+		return cyclic
+	}
+
+	cyclic := false
+	s.state.vertices.each(func(vertex string, v frozenVertex) bool {
+		if state[vertex] == unvisited {
+			cyclic = visit(vertex, v)
+		}
+		return !cyclic
+	})
+
+	return cyclic
+}
+
+// FindComponents returns the vertices reachable from each of the vertices not reached before,
+// ordered by their first vertex in the order vertices were added to the graph.
+//
+// Time complexity: O((v+e)*log(v)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v), where v is number of vertices
+func (s *Snapshot) FindComponents() ([]*list.List, error) {
+	var components []*list.List
+	visited := make(map[string]bool, s.state.vertices.len())
+
+	s.state.order.each(func(_ uint64, vertex string) bool {
+		if !visited[vertex] {
+			component := list.New()
+			s.dfs(vertex, func(node string) {
+				component.PushBack(node)
+			}, visited)
+			components = append(components, component)
+		}
+		return true
+	})
+
+	return components, nil
+}
+
+// String lists the vertices with their neighbors in the format of the adjacency list.
+func (s *Snapshot) String() string {
+	if s.state.vertices.len() == 0 {
+		return "[]"
+	}
+
+	var buffer bytes.Buffer
+	s.state.order.each(func(_ uint64, vertex string) bool {
+		buffer.WriteString(fmt.Sprintf("%v [", vertex))
+
+		v, _ := s.state.vertices.get(vertex)
+		first := true
+		s.state.neighbors(v, vertex, func(neighbor string) {
+			if !first {
+				buffer.WriteString(", ")
+			}
+			first = false
+			buffer.WriteString(neighbor)
+		})
+
+		buffer.WriteString("]\n")
+		return true
+	})
+
+	return buffer.String()
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphSnapshot(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}}),
+			)
+
+			snapshot := g.Snapshot()
+			// Snapshots of an unchanged graph share the whole structure.
+			require.Same(t, snapshot.state.vertices.root, g.Snapshot().state.vertices.root)
+			require.Same(t, snapshot.state.edges.root, g.Snapshot().state.edges.root)
+
+			require.NoError(t, g.AddEdge("C", "D"))
+			require.NoError(t, g.DeleteVertex("A"))
+			require.NotSame(t, snapshot.state.edges.root, g.Snapshot().state.edges.root)
+
+			// The snapshot keeps the state it was taken at.
+			require.False(t, snapshot.IsDirected())
+			require.Equal(t, []string{"A", "B", "C", "D"}, snapshot.ListVertices())
+			require.Equal(t, 4, snapshot.Vertices())
+			require.Equal(t, 2, snapshot.Edges())
+			require.True(t, snapshot.HasVertex("A"))
+			require.True(t, snapshot.HasEdge("A", "B"))
+			require.False(t, snapshot.HasEdge("C", "D"))
+			require.Equal(t, []Edge{{ID: 0, Source: "A", Target: "B", Weight: DefaultWeight}, {ID: 1, Source: "B", Target: "C", Weight: DefaultWeight}}, snapshot.ListEdges())
+
+			var visited []string
+			require.NoError(t, snapshot.BFS("A", func(vertex string) {
+				visited = append(visited, vertex)
+			}))
+			require.Equal(t, []string{"A", "B", "C"}, visited)
+
+			components, err := snapshot.FindComponents()
+			require.NoError(t, err)
+			require.ElementsMatch(t, [][]string{{"A", "B", "C"}, {"D"}}, componentSets(components))
+
+			// A new snapshot reflects the mutations.
+			current := g.Snapshot()
+			require.Equal(t, []string{"B", "C", "D"}, current.ListVertices())
+			require.Equal(t, []Edge{{ID: 1, Source: "B", Target: "C", Weight: DefaultWeight}, {ID: 2, Source: "C", Target: "D", Weight: DefaultWeight}}, current.ListEdges())
+			require.Equal(t, "B [C]\nC [B, D]\nD [C]\n", current.String())
+
+			neighbors, err := current.Neighbors("C")
+			require.NoError(t, err)
+			require.Equal(t, []string{"B", "D"}, neighbors)
+		})
+	}
+}
+
+func TestGraphSnapshotRepresentations(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t, WithVertices([]string{"A", "B", "C"}), WithEdges([][2]string{{"A", "B"}, {"B", "C"}}))
+
+			snapshot := g.Snapshot()
+			require.NoError(t, g.AddEdge("C", "A"))
+
+			require.False(t, snapshot.IsCyclic())
+			require.False(t, snapshot.HasEdge("C", "A"))
+
+			require.True(t, g.Snapshot().IsCyclic())
+			require.Equal(t, g.ListEdges(), g.Snapshot().ListEdges())
+		})
+	}
+}
+
+func TestGraphSnapshotConcurrentWriters(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t, WithVertices([]string{"root"}))
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					vertex := strconv.Itoa(i)
+					require.NoError(t, g.AddVertex(vertex))
+					require.NoError(t, g.AddEdge("root", vertex))
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 50; i++ {
+					snapshot := g.Snapshot()
+
+					visited := 0
+					require.NoError(t, snapshot.DFS("root", func(string) {
+						visited++
+					}))
+					// Every edge connects the root with a new vertex,
+					// so the consistent view has exactly one reachable vertex per edge.
+					require.Equal(t, snapshot.Edges()+1, visited)
+				}
+			}()
+			wg.Wait()
+
+			require.Equal(t, 201, g.Snapshot().Vertices())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package graph
+
+// TransitiveClosure returns a new directed graph which has an edge u -> v
+// if and only if there is a path of at least one edge from u to v in the graph,
+// so a vertex lying on a cycle gets a self-loop.
+//
+// The closure is always backed by the adjacency matrix,
+// so reachability queries with HasEdge take O(1).
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v^2), where v is number of vertices
+func (g *Graph) TransitiveClosure() (*Graph, error) {
+	if !g.repr.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	reach := idx.reachability()
+
+	closure := NewDirectedMatrix(AllowSelfLoops(), WithVertices(idx.names))
+	for u := range reach {
+		for v := range reach[u] {
+			if reach[u][v] {
+				if err := closure.AddEdge(idx.names[u], idx.names[v]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return closure, nil
+}
+
+// TransitiveReduction returns a new directed acyclic graph with the same representation,
+// the same reachability and the fewest possible edges, i.e. an edge u -> v is kept
+// only if there is no other path from u to v.
+//
+// Returns ErrCyclic if the graph contains a cycle, since the reduction of a cyclic graph isn't unique.
+//
+// Time complexity: O(v(v+e)), where v is number of vertices, and e is number of edges
+//
+// Space complexity: O(v^2), where v is number of vertices
+func (g *Graph) TransitiveReduction() (*Graph, error) {
+	if !g.repr.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	idx, err := newAdjIndex(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cycle check uses the same index, so it holds for the reduced graph.
+	reach := idx.reachability()
+	for v := range reach {
+		if reach[v][v] {
+			return nil, ErrCyclic
+		}
+	}
+
+	reduction := g.newEmpty()
+	for _, name := range idx.names {
+		if err := reduction.AddVertex(name); err != nil {
+			return nil, err
+		}
+	}
+
+	for u, successors := range idx.adj {
+		for _, v := range successors {
+			redundant := false
+			for _, w := range successors {
+				if w != v && reach[w][v] {
+					redundant = true
+					break
+				}
+			}
+
+			if !redundant {
+				if err := reduction.AddEdge(idx.names[u], idx.names[v]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return reduction, nil
+}
+
+// reachability returns the matrix where reach[u][v] is true
+// if there is a path of at least one edge from u to v.
+func (idx *adjIndex) reachability() [][]bool {
+	n := idx.len()
+	reach := make([][]bool, n)
+	stack := make([]int, 0, n)
+
+	for u := 0; u < n; u++ {
+		reach[u] = make([]bool, n)
+
+		stack = append(stack[:0], idx.adj[u]...)
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if reach[u][v] {
+				continue
+			}
+			reach[u][v] = true
+			stack = append(stack, idx.adj[v]...)
+		}
+	}
+
+	return reach
+}
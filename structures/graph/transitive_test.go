@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphTransitiveClosure(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B", "C", "D", "E"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "B"}, {"D", "E"}}),
+			)
+
+			closure, err := g.TransitiveClosure()
+			require.NoError(t, err)
+			require.IsType(t, &adjMatrix{}, closure.repr)
+			require.True(t, closure.IsDirected())
+			require.Equal(t, []string{"A", "B", "C", "D", "E"}, closure.ListVertices())
+			require.Equal(t, 7, closure.Edges())
+
+			for _, edge := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "B"}, {"B", "C"}, {"C", "B"}, {"C", "C"}, {"D", "E"}} {
+				require.True(t, closure.HasEdge(edge[0], edge[1]), "edge %v", edge)
+			}
+			require.False(t, closure.HasEdge("A", "A"))
+			require.False(t, closure.HasEdge("E", "D"))
+		})
+	}
+}
+
+func TestGraphTransitiveReduction(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"A", "C"}, {"A", "D"}, {"B", "D"}, {"C", "D"}}),
+			)
+
+			reduction, err := g.TransitiveReduction()
+			require.NoError(t, err)
+			require.IsType(t, g.newEmpty().repr, reduction.repr)
+			require.Equal(t, 4, reduction.Edges())
+			require.False(t, reduction.HasEdge("A", "D"))
+			for _, edge := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}} {
+				require.True(t, reduction.HasEdge(edge[0], edge[1]), "edge %v", edge)
+			}
+			// The original graph is left untouched.
+			require.Equal(t, 5, g.Edges())
+		})
+	}
+}
+
+func TestGraphTransitiveErrors(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("should fail on cyclic graph", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newDirected(t,
+					WithVertices([]string{"A", "B"}),
+					WithEdges([][2]string{{"A", "B"}, {"B", "A"}}),
+				)
+				_, err := g.TransitiveReduction()
+				require.ErrorIs(t, err, ErrCyclic)
+			})
+
+			t.Run("should fail on undirected graph", func(t *testing.T) {
+				t.Parallel()
+				g := repr.newGraph(t, WithVertices([]string{"A"}))
+
+				_, err := g.TransitiveClosure()
+				require.ErrorIs(t, err, ErrNotDirected)
+
+				_, err = g.TransitiveReduction()
+				require.ErrorIs(t, err, ErrNotDirected)
+			})
+		})
+	}
+}
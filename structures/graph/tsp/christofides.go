@@ -0,0 +1,162 @@
+package tsp
+
+import (
+	"math"
+	"sort"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+// Christofides builds the tour from the minimum spanning tree joined with a matching of its odd-degree vertices:
+// the Eulerian circuit of their union is shortcut to visit every vertex once. The tour starts with the first vertex.
+//
+// The odd-degree vertices are matched greedily by increasing weight instead of the exact minimum weight matching,
+// so on metric graphs (satisfying the triangle inequality) the tour is usually, but not always,
+// within 1.5 times the optimum. Improve the result with TwoOpt.
+//
+// Time complexity: O(v^2*log(v)), where v is number of vertices
+//
+// Space complexity: O(v^2), where v is number of vertices
+func Christofides(g *graph.Graph) (Tour, error) {
+	d, err := newDistances(g)
+	if err != nil {
+		return Tour{}, err
+	}
+
+	n := d.len()
+	if n < 3 {
+		tour := make([]int, n)
+		for i := range tour {
+			tour[i] = i
+		}
+		return d.tour(tour), nil
+	}
+
+	// multigraph of the spanning tree and the matching
+	adj := make([][]int, n)
+	link := func(u, v int) {
+		adj[u] = append(adj[u], v)
+		adj[v] = append(adj[v], u)
+	}
+
+	for v, parent := range d.minimumSpanningTree() {
+		if parent != -1 {
+			link(v, parent)
+		}
+	}
+
+	var odd []int
+	for v := range adj {
+		if len(adj[v])%2 == 1 {
+			odd = append(odd, v)
+		}
+	}
+
+	for _, pair := range d.greedyMatching(odd) {
+		link(pair[0], pair[1])
+	}
+
+	// Shortcut the Eulerian circuit skipping visited vertices.
+	visited := make([]bool, n)
+	tour := make([]int, 0, n)
+	for _, v := range eulerianCircuit(adj, 0) {
+		if !visited[v] {
+			visited[v] = true
+			tour = append(tour, v)
+		}
+	}
+
+	return d.tour(tour), nil
+}
+
+// minimumSpanningTree returns the parent of every vertex in the minimum spanning tree rooted at 0 (Prim's algorithm).
+func (d *distances) minimumSpanningTree() []int {
+	n := d.len()
+	parent := make([]int, n)
+	best := make([]float64, n)
+	inTree := make([]bool, n)
+	for v := range best {
+		best[v] = math.Inf(1)
+		parent[v] = -1
+	}
+	best[0] = 0
+
+	for step := 0; step < n; step++ {
+		u := -1
+		for v := range best {
+			if !inTree[v] && (u == -1 || best[v] < best[u]) {
+				u = v
+			}
+		}
+
+		inTree[u] = true
+		for v := range best {
+			if !inTree[v] && d.w[u][v] < best[v] {
+				best[v] = d.w[u][v]
+				parent[v] = u
+			}
+		}
+	}
+
+	return parent
+}
+
+// greedyMatching pairs up the vertices taking the lightest pair of unmatched vertices each time.
+func (d *distances) greedyMatching(vertices []int) [][2]int {
+	var pairs [][2]int
+	for i := range vertices {
+		for j := i + 1; j < len(vertices); j++ {
+			pairs = append(pairs, [2]int{vertices[i], vertices[j]})
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return d.w[pairs[i][0]][pairs[i][1]] < d.w[pairs[j][0]][pairs[j][1]]
+	})
+
+	matched := make(map[int]bool, len(vertices))
+	var matching [][2]int
+	for _, pair := range pairs {
+		if !matched[pair[0]] && !matched[pair[1]] {
+			matched[pair[0]] = true
+			matched[pair[1]] = true
+			matching = append(matching, pair)
+		}
+	}
+	return matching
+}
+
+// eulerianCircuit returns the Eulerian circuit of the connected multigraph with even degrees (Hierholzer's algorithm),
+// the adjacency is consumed.
+func eulerianCircuit(adj [][]int, start int) []int {
+	// removed[u][v] counts the edges u - v already traversed from v, which are still in the list of u
+	removed := make([]map[int]int, len(adj))
+	for v := range removed {
+		removed[v] = make(map[int]int)
+	}
+
+	var circuit []int
+	stack := []int{start}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+
+		// Skip the edges already used from the other end.
+		for len(adj[u]) > 0 && removed[u][adj[u][len(adj[u])-1]] > 0 {
+			v := adj[u][len(adj[u])-1]
+			removed[u][v]--
+			adj[u] = adj[u][:len(adj[u])-1]
+		}
+
+		if len(adj[u]) == 0 {
+			circuit = append(circuit, u)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		v := adj[u][len(adj[u])-1]
+		adj[u] = adj[u][:len(adj[u])-1]
+		removed[v][u]++
+		stack = append(stack, v)
+	}
+
+	return circuit
+}
@@ -0,0 +1,70 @@
+package tsp
+
+import "github.com/dkhrunov/dsa-go/structures/graph"
+
+// HamiltonianPath searches with backtracking for a path which visits every vertex of the graph exactly once,
+// following the direction of edges for directed graphs. The graph doesn't have to be complete or weighted.
+//
+// Returns false if there is no such path.
+//
+// Time complexity: O(v!) in the worst case, where v is number of vertices
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func HamiltonianPath(g *graph.Graph) ([]string, bool, error) {
+	names := g.ListVertices()
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+
+	adj := make([][]int, len(names))
+	for i, name := range names {
+		neighbors, err := g.Neighbors(name)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, neighbor := range neighbors {
+			if j := index[neighbor]; j != i {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return []string{}, true, nil
+	}
+
+	visited := make([]bool, len(names))
+	path := make([]int, 0, len(names))
+
+	var extend func(v int) bool
+	extend = func(v int) bool {
+		visited[v] = true
+		path = append(path, v)
+		if len(path) == len(names) {
+			return true
+		}
+
+		for _, w := range adj[v] {
+			if !visited[w] && extend(w) {
+				return true
+			}
+		}
+
+		visited[v] = false
+		path = path[:len(path)-1]
+		return false
+	}
+
+	for start := range names {
+		if extend(start) {
+			result := make([]string, len(path))
+			for i, v := range path {
+				result[i] = names[v]
+			}
+			return result, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,55 @@
+package tsp
+
+import (
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHamiltonianPath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		graph *graph.Graph
+		want  []string
+	}{
+		{
+			name: "should find path in cycle with chords",
+			graph: graph.New(
+				graph.WithVertices([]string{"A", "B", "C", "D", "E"}),
+				graph.WithEdges([][2]string{{"A", "C"}, {"C", "E"}, {"E", "B"}, {"B", "D"}, {"A", "B"}}),
+			),
+			want: []string{"A", "C", "E", "B", "D"},
+		},
+		{
+			name: "should not find path in star",
+			graph: graph.New(
+				graph.WithVertices([]string{"center", "A", "B", "C"}),
+				graph.WithEdges([][2]string{{"center", "A"}, {"center", "B"}, {"center", "C"}}),
+			),
+		},
+		{
+			name: "should follow direction of edges",
+			graph: graph.NewDirected(
+				graph.WithVertices([]string{"A", "B", "C"}),
+				graph.WithEdges([][2]string{{"B", "A"}, {"C", "B"}}),
+			),
+			want: []string{"C", "B", "A"},
+		},
+		{
+			name:  "should not find path in disconnected graph",
+			graph: graph.New(graph.WithVertices([]string{"A", "B"})),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path, found, err := HamiltonianPath(tt.graph)
+			require.NoError(t, err)
+			require.Equal(t, tt.want != nil, found)
+			require.Equal(t, tt.want, path)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package tsp
+
+import (
+	"math"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+// MaxHeldKarpVertices limits the size of graphs solved by HeldKarp,
+// since its memory grows as v*2^v.
+const MaxHeldKarpVertices = 16
+
+// HeldKarp finds the optimal tour with the Held–Karp dynamic programming over subsets of vertices,
+// the tour starts with the first vertex.
+//
+// Returns ErrTooManyVertices if the graph has more than MaxHeldKarpVertices vertices.
+//
+// Time complexity: O(v^2*2^v), where v is number of vertices
+//
+// Space complexity: O(v*2^v), where v is number of vertices
+func HeldKarp(g *graph.Graph) (Tour, error) {
+	d, err := newDistances(g)
+	if err != nil {
+		return Tour{}, err
+	}
+
+	n := d.len()
+	if n > MaxHeldKarpVertices {
+		return Tour{}, ErrTooManyVertices
+	}
+	if n < 3 {
+		tour := make([]int, n)
+		for i := range tour {
+			tour[i] = i
+		}
+		return d.tour(tour), nil
+	}
+
+	// cost[mask][v] is the cheapest path from 0 through the vertices of mask ending at v,
+	// vertex 0 isn't included into masks.
+	full := 1 << (n - 1)
+	cost := make([][]float64, full)
+	parent := make([][]int8, full)
+	for mask := range cost {
+		cost[mask] = make([]float64, n)
+		parent[mask] = make([]int8, n)
+		for v := range cost[mask] {
+			cost[mask][v] = math.Inf(1)
+		}
+	}
+	for v := 1; v < n; v++ {
+		cost[1<<(v-1)][v] = d.w[0][v]
+		parent[1<<(v-1)][v] = 0
+	}
+
+	for mask := 1; mask < full; mask++ {
+		for v := 1; v < n; v++ {
+			bit := 1 << (v - 1)
+			if mask&bit == 0 || math.IsInf(cost[mask][v], 1) {
+				continue
+			}
+			for u := 1; u < n; u++ {
+				next := 1 << (u - 1)
+				if mask&next != 0 {
+					continue
+				}
+				if c := cost[mask][v] + d.w[v][u]; c < cost[mask|next][u] {
+					cost[mask|next][u] = c
+					parent[mask|next][u] = int8(v)
+				}
+			}
+		}
+	}
+
+	last := 1
+	for v := 2; v < n; v++ {
+		if cost[full-1][v]+d.w[v][0] < cost[full-1][last]+d.w[last][0] {
+			last = v
+		}
+	}
+
+	tour := make([]int, n)
+	mask := full - 1
+	for i, v := n-1, last; i > 0; i-- {
+		tour[i] = v
+		prev := int(parent[mask][v])
+		mask &^= 1 << (v - 1)
+		v = prev
+	}
+
+	return d.tour(tour), nil
+}
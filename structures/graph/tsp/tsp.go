@@ -0,0 +1,196 @@
+// Package tsp implements traveling salesman heuristics and exact algorithms
+// over complete weighted undirected graphs, and the Hamiltonian path search.
+package tsp
+
+import (
+	"errors"
+	"math"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+)
+
+var (
+	ErrNotComplete = errors.New("graph is not complete")
+
+	ErrInvalidTour = errors.New("tour must visit every vertex of the graph exactly once")
+
+	ErrTooManyVertices = errors.New("graph has too many vertices for the exact algorithm")
+)
+
+// Tour is a closed route which visits every vertex once and returns from the last vertex to the first one,
+// Cost includes the returning edge.
+type Tour struct {
+	Vertices []string
+	Cost     float64
+}
+
+// distances is the weight matrix of a complete graph with vertices numbered in the order they were added.
+type distances struct {
+	names []string
+	index map[string]int
+	w     [][]float64
+}
+
+// newDistances builds the weight matrix of the complete undirected graph,
+// of parallel edges the lightest one is used and self-loops are ignored.
+func newDistances(g *graph.Graph) (*distances, error) {
+	if g.IsDirected() {
+		return nil, graph.ErrNotUndirected
+	}
+
+	names := g.ListVertices()
+	d := &distances{
+		names: names,
+		index: make(map[string]int, len(names)),
+		w:     make([][]float64, len(names)),
+	}
+	for i, name := range names {
+		d.index[name] = i
+		d.w[i] = make([]float64, len(names))
+		for j := range d.w[i] {
+			if i != j {
+				d.w[i][j] = math.Inf(1)
+			}
+		}
+	}
+
+	for _, edge := range g.ListEdges() {
+		i, j := d.index[edge.Source], d.index[edge.Target]
+		if i != j && edge.Weight < d.w[i][j] {
+			d.w[i][j] = edge.Weight
+			d.w[j][i] = edge.Weight
+		}
+	}
+
+	for i := range d.w {
+		for j := range d.w[i] {
+			if math.IsInf(d.w[i][j], 1) {
+				return nil, ErrNotComplete
+			}
+		}
+	}
+
+	return d, nil
+}
+
+func (d *distances) len() int {
+	return len(d.names)
+}
+
+// cost returns the cost of the closed tour.
+func (d *distances) cost(tour []int) float64 {
+	if len(tour) < 2 {
+		return 0
+	}
+
+	cost := d.w[tour[len(tour)-1]][tour[0]]
+	for i := 1; i < len(tour); i++ {
+		cost += d.w[tour[i-1]][tour[i]]
+	}
+	return cost
+}
+
+func (d *distances) tour(tour []int) Tour {
+	names := make([]string, len(tour))
+	for i, v := range tour {
+		names[i] = d.names[v]
+	}
+	return Tour{Vertices: names, Cost: d.cost(tour)}
+}
+
+// NearestNeighbor builds the tour starting at the given vertex and moving each time
+// to the nearest unvisited vertex.
+//
+// Time complexity: O(v^2), where v is number of vertices
+//
+// Space complexity: O(v^2), where v is number of vertices
+func NearestNeighbor(g *graph.Graph, start string) (Tour, error) {
+	d, err := newDistances(g)
+	if err != nil {
+		return Tour{}, err
+	}
+
+	s, ok := d.index[start]
+	if !ok {
+		return Tour{}, graph.ErrVertexNotFound(start)
+	}
+
+	visited := make([]bool, d.len())
+	visited[s] = true
+	tour := []int{s}
+
+	for len(tour) < d.len() {
+		curr := tour[len(tour)-1]
+		next := -1
+		for v := range d.w[curr] {
+			if !visited[v] && (next == -1 || d.w[curr][v] < d.w[curr][next]) {
+				next = v
+			}
+		}
+
+		visited[next] = true
+		tour = append(tour, next)
+	}
+
+	return d.tour(tour), nil
+}
+
+// TwoOpt improves the tour by reversing its segments while it makes the tour cheaper,
+// the result starts with the same vertex. The tour must visit every vertex of the graph exactly once.
+//
+// Time complexity: O(k*v^2), where v is number of vertices and k is number of improving passes
+//
+// Space complexity: O(v^2), where v is number of vertices
+func TwoOpt(g *graph.Graph, tour Tour) (Tour, error) {
+	d, err := newDistances(g)
+	if err != nil {
+		return Tour{}, err
+	}
+
+	if len(tour.Vertices) != d.len() {
+		return Tour{}, ErrInvalidTour
+	}
+
+	route := make([]int, len(tour.Vertices))
+	seen := make([]bool, d.len())
+	for i, name := range tour.Vertices {
+		v, ok := d.index[name]
+		if !ok || seen[v] {
+			return Tour{}, ErrInvalidTour
+		}
+		seen[v] = true
+		route[i] = v
+	}
+
+	d.twoOpt(route)
+
+	return d.tour(route), nil
+}
+
+// twoOpt applies improving 2-opt moves in place until there are none:
+// edges (a, b) and (c, e) are replaced with (a, c) and (b, e) by reversing the segment b..c.
+func (d *distances) twoOpt(route []int) {
+	// Improvements smaller than epsilon are ignored to avoid endless loops caused by rounding errors.
+	const epsilon = 1e-9
+
+	n := len(route)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				a, b := route[i], route[i+1]
+				c, e := route[j], route[(j+1)%n]
+				if a == e {
+					continue
+				}
+
+				if delta := d.w[a][c] + d.w[b][e] - d.w[a][b] - d.w[c][e]; delta < -epsilon {
+					for l, r := i+1, j; l < r; l, r = l+1, r-1 {
+						route[l], route[r] = route[r], route[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+}
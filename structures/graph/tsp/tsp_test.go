@@ -0,0 +1,168 @@
+package tsp
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/structures/graph"
+	"github.com/stretchr/testify/require"
+)
+
+// createEuclidean creates the complete graph of random points on a plane weighted by the distances between them.
+func createEuclidean(t *testing.T, n int, seed int64) *graph.Graph {
+	t.Helper()
+	rnd := rand.New(rand.NewSource(seed))
+
+	x, y := make([]float64, n), make([]float64, n)
+	g := graph.New()
+	for i := 0; i < n; i++ {
+		x[i], y[i] = rnd.Float64()*100, rnd.Float64()*100
+		require.NoError(t, g.AddVertex(fmt.Sprint(i)))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			_, err := g.AddWeightedEdge(fmt.Sprint(i), fmt.Sprint(j), math.Hypot(x[i]-x[j], y[i]-y[j]))
+			require.NoError(t, err)
+		}
+	}
+	return g
+}
+
+// requireTour checks that the tour visits every vertex once and its cost is correct.
+func requireTour(t *testing.T, g *graph.Graph, tour Tour) {
+	t.Helper()
+	require.ElementsMatch(t, g.ListVertices(), tour.Vertices)
+
+	cost := 0.0
+	for i, source := range tour.Vertices {
+		target := tour.Vertices[(i+1)%len(tour.Vertices)]
+		edges := g.EdgesBetween(source, target)
+		require.NotEmpty(t, edges)
+		cost += edges[0].Weight
+	}
+	require.InDelta(t, cost, tour.Cost, 1e-6)
+}
+
+// bruteForce returns the cost of the optimal tour by checking all permutations.
+func bruteForce(t *testing.T, g *graph.Graph) float64 {
+	t.Helper()
+	d, err := newDistances(g)
+	require.NoError(t, err)
+
+	route := make([]int, d.len())
+	for i := range route {
+		route[i] = i
+	}
+
+	best := math.Inf(1)
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(route) {
+			best = math.Min(best, d.cost(route))
+			return
+		}
+		for i := k; i < len(route); i++ {
+			route[k], route[i] = route[i], route[k]
+			permute(k + 1)
+			route[k], route[i] = route[i], route[k]
+		}
+	}
+	permute(1)
+	return best
+}
+
+func TestNearestNeighbor(t *testing.T) {
+	t.Parallel()
+	g := graph.New(
+		graph.WithVertices([]string{"A", "B", "C", "D"}),
+		graph.WithWeightedEdges([]graph.Edge{
+			{Source: "A", Target: "B", Weight: 1},
+			{Source: "A", Target: "C", Weight: 4},
+			{Source: "A", Target: "D", Weight: 3},
+			{Source: "B", Target: "C", Weight: 2},
+			{Source: "B", Target: "D", Weight: 5},
+			{Source: "C", Target: "D", Weight: 6},
+		}),
+	)
+
+	tour, err := NearestNeighbor(g, "A")
+	require.NoError(t, err)
+	require.Equal(t, Tour{Vertices: []string{"A", "B", "C", "D"}, Cost: 12}, tour)
+
+	_, err = NearestNeighbor(g, "Z")
+	require.EqualError(t, err, graph.ErrVertexNotFound("Z").Error())
+}
+
+func TestTwoOpt(t *testing.T) {
+	t.Parallel()
+	g := createEuclidean(t, 30, 1)
+
+	initial, err := NearestNeighbor(g, "0")
+	require.NoError(t, err)
+
+	improved, err := TwoOpt(g, initial)
+	require.NoError(t, err)
+	requireTour(t, g, improved)
+	require.LessOrEqual(t, improved.Cost, initial.Cost)
+	require.Equal(t, "0", improved.Vertices[0])
+
+	_, err = TwoOpt(g, Tour{Vertices: []string{"0", "1"}})
+	require.ErrorIs(t, err, ErrInvalidTour)
+}
+
+func TestHeuristicsOnEuclideanGraphs(t *testing.T) {
+	t.Parallel()
+	for seed := int64(0); seed < 5; seed++ {
+		g := createEuclidean(t, 8, seed)
+		optimum := bruteForce(t, g)
+
+		exact, err := HeldKarp(g)
+		require.NoError(t, err)
+		requireTour(t, g, exact)
+		require.InDelta(t, optimum, exact.Cost, 1e-6)
+
+		nearest, err := NearestNeighbor(g, "0")
+		require.NoError(t, err)
+		requireTour(t, g, nearest)
+		require.GreaterOrEqual(t, nearest.Cost, optimum-1e-6)
+
+		christofides, err := Christofides(g)
+		require.NoError(t, err)
+		requireTour(t, g, christofides)
+		require.LessOrEqual(t, christofides.Cost, 2*optimum)
+
+		improved, err := TwoOpt(g, christofides)
+		require.NoError(t, err)
+		require.LessOrEqual(t, improved.Cost, christofides.Cost+1e-6)
+	}
+}
+
+func TestSmallGraphs(t *testing.T) {
+	t.Parallel()
+	for n := 0; n < 3; n++ {
+		g := createEuclidean(t, n, 1)
+
+		exact, err := HeldKarp(g)
+		require.NoError(t, err)
+		require.Len(t, exact.Vertices, n)
+
+		christofides, err := Christofides(g)
+		require.NoError(t, err)
+		require.Equal(t, exact, christofides)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	incomplete := graph.New(graph.WithVertices([]string{"A", "B", "C"}), graph.WithEdges([][2]string{{"A", "B"}}))
+	_, err := Christofides(incomplete)
+	require.ErrorIs(t, err, ErrNotComplete)
+
+	_, err = HeldKarp(graph.NewDirected())
+	require.ErrorIs(t, err, graph.ErrNotUndirected)
+
+	_, err = HeldKarp(createEuclidean(t, MaxHeldKarpVertices+1, 1))
+	require.ErrorIs(t, err, ErrTooManyVertices)
+}
@@ -0,0 +1,270 @@
+package graph
+
+import "math/rand"
+
+// Neighbor is a vertex adjacent to the current vertex of a walk
+// together with the total weight of the edges leading to it.
+type Neighbor struct {
+	Vertex string
+	Weight float64
+}
+
+// WalkStrategy chooses the next vertex of the walk, which starts with walk[0] and ends with the current vertex,
+// neighbors returns the vertices adjacent to the given one and rng is the source of randomness of the walk.
+//
+// Returning false stops the walk.
+type WalkStrategy func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error)
+
+// UniformWalk moves to a neighbor of the current vertex chosen uniformly at random,
+// weights and parallel edges are ignored. The walk stops at a vertex without outgoing edges.
+func UniformWalk() WalkStrategy {
+	return func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+		next := neighbors(walk[len(walk)-1])
+		if len(next) == 0 {
+			return "", false, nil
+		}
+		return next[rng.Intn(len(next))].Vertex, true, nil
+	}
+}
+
+// WeightedWalk moves to a neighbor of the current vertex with the probability proportional
+// to the total weight of the edges leading to it. The walk stops at a vertex without outgoing edges.
+func WeightedWalk() WalkStrategy {
+	return func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+		next := neighbors(walk[len(walk)-1])
+		weights := make([]float64, len(next))
+		for k, neighbor := range next {
+			weights[k] = neighbor.Weight
+		}
+		return choose(rng, next, weights)
+	}
+}
+
+// RestartWalk jumps back to the start of the walk with the given probability
+// or when the next strategy stops the walk, otherwise it moves as the next strategy does.
+func RestartWalk(probability float64, next WalkStrategy) WalkStrategy {
+	return func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+		if probability < 0 || probability > 1 {
+			return "", false, ErrInvalidWalkParameter
+		}
+
+		if rng.Float64() < probability {
+			return walk[0], true, nil
+		}
+
+		vertex, ok, err := next(walk, neighbors, rng)
+		if err != nil || ok {
+			return vertex, ok, err
+		}
+
+		// A dead end restarts the walk, unless the start itself is a dead end.
+		if len(neighbors(walk[0])) == 0 {
+			return "", false, nil
+		}
+		return walk[0], true, nil
+	}
+}
+
+// Node2VecWalk is the second order biased walk of node2vec: the weight of the edge to the next vertex
+// is divided by p if it returns to the previous vertex, kept if the next vertex is adjacent
+// to the previous one, and divided by q otherwise.
+//
+// Low p keeps the walk local, low q pushes it outwards.
+//
+// https://arxiv.org/abs/1607.00653
+func Node2VecWalk(p, q float64) WalkStrategy {
+	return func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+		if p <= 0 || q <= 0 {
+			return "", false, ErrInvalidWalkParameter
+		}
+
+		next := neighbors(walk[len(walk)-1])
+		biased := make([]float64, len(next))
+		if len(walk) == 1 {
+			for k, neighbor := range next {
+				biased[k] = neighbor.Weight
+			}
+			return choose(rng, next, biased)
+		}
+
+		prev := walk[len(walk)-2]
+		prevNeighbors := make(map[string]bool)
+		for _, neighbor := range neighbors(prev) {
+			prevNeighbors[neighbor.Vertex] = true
+		}
+
+		for k, neighbor := range next {
+			switch {
+			case neighbor.Vertex == prev:
+				biased[k] = neighbor.Weight / p
+			case prevNeighbors[neighbor.Vertex]:
+				biased[k] = neighbor.Weight
+			default:
+				biased[k] = neighbor.Weight / q
+			}
+		}
+
+		return choose(rng, next, biased)
+	}
+}
+
+// choose picks a neighbor with the probability proportional to its weight,
+// the walk stops if the total weight is zero.
+func choose(rng *rand.Rand, neighbors []Neighbor, weights []float64) (string, bool, error) {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+	if total == 0 {
+		return "", false, nil
+	}
+
+	r := rng.Float64() * total
+	for k, weight := range weights {
+		r -= weight
+		if r < 0 {
+			return neighbors[k].Vertex, true, nil
+		}
+	}
+
+	// Rounding errors can leave r slightly above zero, take the last possible neighbor.
+	for k := len(weights) - 1; k >= 0; k-- {
+		if weights[k] > 0 {
+			return neighbors[k].Vertex, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// walker holds the state shared by the steps of random walks.
+type walker struct {
+	idx *adjIndex
+	rng *rand.Rand
+	// neighbors[v] are the neighbors of v, built lazily on the first visit.
+	neighbors [][]Neighbor
+}
+
+func (g *Graph) newWalker(seed int64) (*walker, error) {
+	idx, edges, err := newAdjIndexWithEdges(g.repr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range edges {
+		if edge.Weight < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+	idx.loadWeights(edges, func(a, b float64) float64 {
+		return a + b
+	})
+
+	return &walker{
+		idx:       idx,
+		rng:       rand.New(rand.NewSource(seed)),
+		neighbors: make([][]Neighbor, idx.len()),
+	}, nil
+}
+
+// neighborsOf returns the neighbors of the vertex, nil for unknown vertices.
+func (w *walker) neighborsOf(vertex string) []Neighbor {
+	v, ok := w.idx.index[vertex]
+	if !ok {
+		return nil
+	}
+
+	if w.neighbors[v] == nil {
+		w.neighbors[v] = make([]Neighbor, len(w.idx.adj[v]))
+		for k, x := range w.idx.adj[v] {
+			w.neighbors[v][k] = Neighbor{Vertex: w.idx.names[x], Weight: w.idx.weights[v][k]}
+		}
+	}
+	return w.neighbors[v]
+}
+
+func (w *walker) walk(start string, length int, strategy WalkStrategy) ([]string, error) {
+	walk := make([]string, 0, length)
+	if length > 0 {
+		walk = append(walk, start)
+	}
+
+	for len(walk) < length {
+		next, ok, err := strategy(walk, w.neighborsOf, w.rng)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if _, ok := w.idx.index[next]; !ok {
+			return nil, ErrVertexNotFound(next)
+		}
+		walk = append(walk, next)
+	}
+
+	return walk, nil
+}
+
+// RandomWalk returns a walk of at most length vertices from the start vertex,
+// the same seed produces the same walk on the same graph.
+//
+// Returns ErrNegativeWeight if the graph has an edge with negative weight
+// and ErrInvalidWalkParameter if the length is negative.
+//
+// Time complexity: O(v+e+l*d), where v is number of vertices, e is number of edges,
+// l is the length of the walk and d is the maximum degree
+//
+// Space complexity: O(v+e), where v is number of vertices, and e is number of edges
+func (g *Graph) RandomWalk(start string, length int, strategy WalkStrategy, seed int64) ([]string, error) {
+	if length < 0 {
+		return nil, ErrInvalidWalkParameter
+	}
+
+	w, err := g.newWalker(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := w.idx.index[start]; !ok {
+		return nil, ErrVertexNotFound(start)
+	}
+
+	return w.walk(start, length, strategy)
+}
+
+// RandomWalks returns walksPerVertex walks of at most length vertices from every vertex,
+// e.g. the corpus for DeepWalk or node2vec embeddings.
+//
+// Walks are grouped in rounds, each round has a walk from every vertex in the order they were added to the graph,
+// the same seed produces the same walks on the same graph.
+//
+// Returns ErrNegativeWeight if the graph has an edge with negative weight
+// and ErrInvalidWalkParameter if the number of walks or the length is negative.
+//
+// Time complexity: O(v+e+r*v*l*d), where v is number of vertices, e is number of edges,
+// r is walks per vertex, l is the length of the walks and d is the maximum degree
+//
+// Space complexity: O(v+e+r*v*l)
+func (g *Graph) RandomWalks(walksPerVertex, length int, strategy WalkStrategy, seed int64) ([][]string, error) {
+	if walksPerVertex < 0 || length < 0 {
+		return nil, ErrInvalidWalkParameter
+	}
+
+	w, err := g.newWalker(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	walks := make([][]string, 0, walksPerVertex*w.idx.len())
+	for round := 0; round < walksPerVertex; round++ {
+		for _, vertex := range w.idx.names {
+			walk, err := w.walk(vertex, length, strategy)
+			if err != nil {
+				return nil, err
+			}
+			walks = append(walks, walk)
+		}
+	}
+
+	return walks, nil
+}
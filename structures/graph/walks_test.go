@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireWalk checks that every step of the walk follows an edge of the graph.
+func requireWalk(t *testing.T, g *Graph, walk []string) {
+	t.Helper()
+	for i := 1; i < len(walk); i++ {
+		require.True(t, g.HasEdge(walk[i-1], walk[i]), "no edge %v -> %v", walk[i-1], walk[i])
+	}
+}
+
+func TestGraphRandomWalk(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		strategy WalkStrategy
+	}{
+		{name: "uniform", strategy: UniformWalk()},
+		{name: "weighted", strategy: WeightedWalk()},
+		{name: "node2vec", strategy: Node2VecWalk(0.5, 2)},
+	}
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					t.Parallel()
+					g := repr.newGraph(t,
+						WithVertices([]string{"A", "B", "C", "D"}),
+						WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}, {"C", "D"}, {"D", "A"}}),
+					)
+
+					walk, err := g.RandomWalk("A", 20, tt.strategy, 42)
+					require.NoError(t, err)
+					require.Len(t, walk, 20)
+					require.Equal(t, "A", walk[0])
+					requireWalk(t, g, walk)
+
+					same, err := g.RandomWalk("A", 20, tt.strategy, 42)
+					require.NoError(t, err)
+					require.Equal(t, walk, same)
+				})
+			}
+		})
+	}
+}
+
+func TestGraphRandomWalkStops(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}}),
+			)
+
+			walk, err := g.RandomWalk("A", 10, UniformWalk(), 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "B", "C"}, walk)
+
+			walk, err = g.RandomWalk("A", 0, UniformWalk(), 1)
+			require.NoError(t, err)
+			require.Empty(t, walk)
+
+			// A dead end restarts the walk.
+			walk, err = g.RandomWalk("A", 7, RestartWalk(0, UniformWalk()), 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "B", "C", "A", "B", "C", "A"}, walk)
+
+			_, err = g.RandomWalk("Z", 10, UniformWalk(), 1)
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+
+			_, err = g.RandomWalk("A", 10, RestartWalk(2, UniformWalk()), 1)
+			require.ErrorIs(t, err, ErrInvalidWalkParameter)
+
+			_, err = g.RandomWalk("A", 10, Node2VecWalk(0, 1), 1)
+			require.ErrorIs(t, err, ErrInvalidWalkParameter)
+
+			_, err = g.RandomWalk("A", -1, UniformWalk(), 1)
+			require.ErrorIs(t, err, ErrInvalidWalkParameter)
+		})
+	}
+}
+
+func TestGraphCustomWalk(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}}),
+			)
+
+			// Always moves to the lexicographically greatest neighbor not visited yet.
+			greedy := func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+				visited := make(map[string]bool, len(walk))
+				for _, vertex := range walk {
+					visited[vertex] = true
+				}
+				next, ok := "", false
+				for _, neighbor := range neighbors(walk[len(walk)-1]) {
+					if !visited[neighbor.Vertex] && neighbor.Vertex > next {
+						next, ok = neighbor.Vertex, true
+					}
+				}
+				return next, ok, nil
+			}
+
+			walk, err := g.RandomWalk("B", 10, greedy, 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{"B", "C", "D"}, walk)
+
+			unknown := func(walk []string, neighbors func(vertex string) []Neighbor, rng *rand.Rand) (string, bool, error) {
+				return "Z", true, nil
+			}
+			_, err = g.RandomWalk("A", 10, unknown, 1)
+			require.EqualError(t, err, ErrVertexNotFound("Z").Error())
+		})
+	}
+}
+
+func TestGraphWeightedWalk(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newDirected(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithWeightedEdges([]Edge{
+					{Source: "A", Target: "B", Weight: 0},
+					{Source: "A", Target: "C", Weight: 3},
+					{Source: "B", Target: "A", Weight: 1},
+					{Source: "C", Target: "A", Weight: 1},
+				}),
+			)
+
+			walk, err := g.RandomWalk("A", 11, WeightedWalk(), 7)
+			require.NoError(t, err)
+			require.Equal(t, []string{"A", "C", "A", "C", "A", "C", "A", "C", "A", "C", "A"}, walk)
+
+			negative := repr.newDirected(t, WithVertices([]string{"A", "B"}))
+			_, err = negative.AddWeightedEdge("A", "B", -1)
+			require.NoError(t, err)
+			_, err = negative.RandomWalk("A", 2, WeightedWalk(), 7)
+			require.ErrorIs(t, err, ErrNegativeWeight)
+		})
+	}
+}
+
+func TestGraphRestartWalk(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C", "D", "E"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"}}),
+			)
+
+			walk, err := g.RandomWalk("A", 100, RestartWalk(1, UniformWalk()), 3)
+			require.NoError(t, err)
+			for _, vertex := range walk {
+				require.Equal(t, "A", vertex)
+			}
+
+			walk, err = g.RandomWalk("C", 100, RestartWalk(0.3, UniformWalk()), 3)
+			require.NoError(t, err)
+			restarts := 0
+			for i := 1; i < len(walk); i++ {
+				if !g.HasEdge(walk[i-1], walk[i]) {
+					require.Equal(t, "C", walk[i])
+					restarts++
+				}
+			}
+			require.Len(t, walk, 100)
+			require.Positive(t, restarts)
+		})
+	}
+}
+
+func TestGraphNode2VecWalk(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			// Star: the walk must return to the center after every step.
+			g := repr.newGraph(t,
+				WithVertices([]string{"center", "A", "B", "C"}),
+				WithEdges([][2]string{{"center", "A"}, {"center", "B"}, {"center", "C"}, {"A", "B"}}),
+			)
+
+			// A huge q forbids moving away from the previous vertex,
+			// so from A or B the walk goes back to the center or to the common neighbor.
+			walk, err := g.RandomWalk("center", 50, Node2VecWalk(1, 1e9), 5)
+			require.NoError(t, err)
+			requireWalk(t, g, walk)
+			for i := 2; i < len(walk); i++ {
+				prev, next := walk[i-2], walk[i]
+				require.True(t, prev == next || g.HasEdge(prev, next), "%v moved away from %v", next, prev)
+			}
+		})
+	}
+}
+
+func TestGraphRandomWalks(t *testing.T) {
+	t.Parallel()
+	for _, repr := range representations() {
+		repr := repr
+		t.Run(repr.name, func(t *testing.T) {
+			t.Parallel()
+			g := repr.newGraph(t,
+				WithVertices([]string{"A", "B", "C"}),
+				WithEdges([][2]string{{"A", "B"}, {"B", "C"}}),
+			)
+
+			walks, err := g.RandomWalks(2, 5, UniformWalk(), 11)
+			require.NoError(t, err)
+			require.Len(t, walks, 6)
+			for i, walk := range walks {
+				require.Len(t, walk, 5)
+				require.Equal(t, []string{"A", "B", "C"}[i%3], walk[0])
+				requireWalk(t, g, walk)
+			}
+
+			same, err := g.RandomWalks(2, 5, UniformWalk(), 11)
+			require.NoError(t, err)
+			require.Equal(t, walks, same)
+
+			_, err = g.RandomWalks(-1, 5, UniformWalk(), 11)
+			require.ErrorIs(t, err, ErrInvalidWalkParameter)
+		})
+	}
+}
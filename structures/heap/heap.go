@@ -5,15 +5,26 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
-type Heap[T constraints.Ordered] struct {
+// Heap keeps on top the item which passes the comparator against all others.
+type Heap[T comparable] struct {
 	arr  []T
-	comp utils.ComparatorFn[T]
+	comp func(a, b T) int8
 }
 
 func New[T constraints.Ordered](comp utils.ComparatorFn[T], items ...T) *Heap[T] {
 	return &Heap[T]{items, comp}
 }
 
+// NewWithComparator creates a heap of any comparable items, e.g. structs with a priority,
+// comp returns 1 if a must be closer to the top than b, -1 if it mustn't and 0 if they are equal.
+func NewWithComparator[T comparable](comp func(a, b T) int8, items ...T) *Heap[T] {
+	h := &Heap[T]{comp: comp}
+	for _, v := range items {
+		h.Insert(v)
+	}
+	return h
+}
+
 func NewMaxHeap[T constraints.Ordered](items ...T) *Heap[T] {
 	h := &Heap[T]{comp: utils.GreaterComparator[T]}
 	for _, v := range items {
@@ -30,18 +41,45 @@ func NewMinHeap[T constraints.Ordered](items ...T) *Heap[T] {
 	return h
 }
 
+// Time complexity: O(log(n)), where n is number of items
+//
+// Space complexity: O(1)
 func (h *Heap[T]) Insert(v T) {
+	h.arr = append(h.arr, v)
+	h.heapifyUp(h.Size() - 1)
+}
+
+// Peek returns the top item without removing it, false if the heap is empty.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+func (h *Heap[T]) Peek() (T, bool) {
 	if h.IsEmpty() {
-		h.arr = append(h.arr, v)
-	} else {
-		h.arr = append(h.arr, v)
+		return utils.Zero[T](), false
+	}
+	return h.arr[0], true
+}
 
-		for i := h.Size()/2 - 1; i >= 0; i-- {
-			h.heapify(i)
-		}
-		// OR
-		// h.heapifyUp(h.Size() - 1)
+// Pop removes and returns the top item, false if the heap is empty.
+//
+// Time complexity: O(log(n)), where n is number of items
+//
+// Space complexity: O(1)
+func (h *Heap[T]) Pop() (T, bool) {
+	if h.IsEmpty() {
+		return utils.Zero[T](), false
 	}
+
+	top := h.arr[0]
+	last := h.Size() - 1
+	h.arr[0] = h.arr[last]
+	h.arr = h.arr[:last]
+	if h.Size() > 0 {
+		h.heapify(0)
+	}
+
+	return top, true
 }
 
 func (h *Heap[T]) Delete(v T) {
@@ -78,16 +116,17 @@ func (h *Heap[T]) Size() int {
 	return len(h.arr)
 }
 
-// func (h *Heap[T]) heapifyUp(childIdx int) {
-// 	parentIdx := (childIdx - 1) / 2
+func (h *Heap[T]) heapifyUp(childIdx int) {
+	if childIdx == 0 {
+		return
+	}
 
-// 	if childIdx < h.Size() && parentIdx >= 0 {
-// 		if compare := h.comp(h.arr[childIdx], h.arr[parentIdx]); compare == 1 {
-// 			h.arr[childIdx], h.arr[parentIdx] = h.arr[parentIdx], h.arr[childIdx]
-// 			h.heapifyUp(parentIdx)
-// 		}
-// 	}
-// }
+	parentIdx := (childIdx - 1) / 2
+	if compare := h.comp(h.arr[childIdx], h.arr[parentIdx]); compare == 1 {
+		h.arr[childIdx], h.arr[parentIdx] = h.arr[parentIdx], h.arr[childIdx]
+		h.heapifyUp(parentIdx)
+	}
+}
 
 func (h *Heap[T]) heapify(parentIdx int) {
 	swapIdx := parentIdx
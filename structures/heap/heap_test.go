@@ -46,3 +46,44 @@ func TestMinHeap(t *testing.T) {
 		t.Fatalf(`MinHeap(%v) = %v, want match for %v`, input, heap.arr, wantAfterDelete)
 	}
 }
+
+func TestPop(t *testing.T) {
+	input := []int{2, 56, 20, 37, 90, 36, 13, 1, 18, 5, 43}
+	heap := NewMinHeap(input...)
+
+	if top, ok := heap.Peek(); !ok || top != 1 {
+		t.Fatalf(`Peek() = %v, %v, want 1, true`, top, ok)
+	}
+
+	got := make([]int, 0, len(input))
+	for !heap.IsEmpty() {
+		v, _ := heap.Pop()
+		got = append(got, v)
+	}
+
+	want := []int{1, 2, 5, 13, 18, 20, 36, 37, 43, 56, 90}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf(`Pop() order = %v, want match for %v`, got, want)
+	}
+
+	if _, ok := heap.Pop(); ok {
+		t.Fatalf(`Pop() on empty heap = _, true, want false`)
+	}
+}
+
+func TestNewWithComparator(t *testing.T) {
+	type item struct {
+		name     string
+		priority int
+	}
+
+	heap := NewWithComparator(func(a, b item) int8 {
+		return utils.LessComparator(a.priority, b.priority)
+	}, item{"c", 3}, item{"a", 1}, item{"b", 2})
+
+	for _, want := range []string{"a", "b", "c"} {
+		if got, ok := heap.Pop(); !ok || got.name != want {
+			t.Fatalf(`Pop() = %v, %v, want %v`, got, ok, want)
+		}
+	}
+}
@@ -9,21 +9,22 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
-type AVLNode[T constraints.Ordered] struct {
-	value               T
-	bf                  int
-	height              int
+type AVLNode[T any] struct {
+	value  T
+	bf     int
+	height int
+	// The number of nodes in the subtree rooted at the node.
+	size                int
 	left, right, parent *AVLNode[T]
 }
 
-// TODO add comparator to constructor and use it in comparisons
-
 // NewAVLNode creates a new AVL node.
-func NewAVLNode[T constraints.Ordered](value T) *AVLNode[T] {
+func NewAVLNode[T any](value T) *AVLNode[T] {
 	return &AVLNode[T]{
 		value:  value,
 		bf:     0,
 		height: 0,
+		size:   1,
 		left:   nil,
 		right:  nil,
 		parent: nil,
@@ -59,16 +60,28 @@ func (node *AVLNode[T]) Parent() *AVLNode[T] {
 	return node.parent
 }
 
-type AVLTree[T constraints.Ordered] struct {
+// AVLTree is a self-balancing binary search tree.
+// The zero value is an empty tree, which orders values with the built-in operators,
+// inserting into it panics unless T is a predeclared integer, float or string type.
+type AVLTree[T any] struct {
 	// Tracks the number of nodes inside the tree.
 	size int
 	// The root node of the AVL tree.
 	root *AVLNode[T]
+	// Orders the values of the tree.
+	compare utils.ComparatorFn[T]
 }
 
-// NewAVLTree creates a new AVL tree.
+// NewAVLTree creates a new AVL tree of ordered values.
 func NewAVLTree[T constraints.Ordered]() *AVLTree[T] {
-	return &AVLTree[T]{size: 0, root: nil}
+	return NewAVLTreeWithComparator(utils.LessComparator[T])
+}
+
+// NewAVLTreeWithComparator creates a new AVL tree ordered by the comparator,
+// compare returns 1 if a goes before b, -1 if b goes before a and 0 if they are equal,
+// e.g. utils.LessComparator and utils.GreaterComparator order values ascending and descending.
+func NewAVLTreeWithComparator[T any](compare utils.ComparatorFn[T]) *AVLTree[T] {
+	return &AVLTree[T]{size: 0, root: nil, compare: compare}
 }
 
 // Root returns the root of the AVLTree.
@@ -79,6 +92,14 @@ func (tree *AVLTree[T]) Root() *AVLNode[T] {
 // Height the height of a rooted tree is the number of edges between the tree's
 // root and its furthest leaf. This means that a tree containing a single
 // node has a height of 0.
+// lazyInit sets the built-in order of a zero-value tree before its first value is added,
+// the comparator isn't used while the tree is empty.
+func (tree *AVLTree[T]) lazyInit() {
+	if tree.compare == nil {
+		tree.compare = orderedComparator[T]()
+	}
+}
+
 func (tree *AVLTree[T]) Height() int {
 	if tree.root == nil {
 		return 0
@@ -102,6 +123,7 @@ func (tree *AVLTree[T]) Size() int {
 // Space complexity: O(h), where 'h' is the height of tree, if we do consider the stack size for function calls.
 // Otherwise, the space complexity of inorder traversal is O(1).
 func (tree *AVLTree[T]) Insert(value T) bool {
+	tree.lazyInit()
 	if tree.contains(tree.Root(), value) {
 		return false
 	}
@@ -117,17 +139,19 @@ func (tree *AVLTree[T]) insert(node, parent *AVLNode[T], value T) *AVLNode[T] {
 	if node == nil {
 		newNode := &AVLNode[T]{
 			value:  value,
+			size:   1,
 			parent: parent,
 		}
 		return newNode
 	}
 
 	// Insert node in left subtree.
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		node.left = tree.insert(node.left, node, value)
 
 		// Insert node in right subtree.
-	} else if value > node.value {
+	} else if cmp > 0 {
 		node.right = tree.insert(node.right, node, value)
 	}
 
@@ -166,12 +190,13 @@ func (tree *AVLTree[T]) delete(node *AVLNode[T], value T) *AVLNode[T] {
 
 	// Dig into left subtree, the value we're looking
 	// for is smaller than the current value.
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		node.left = tree.delete(node.left, value)
 
 		// Dig into right subtree, the value we're looking
 		// for is greater than the current value.
-	} else if value > node.value {
+	} else if cmp > 0 {
 		node.right = tree.delete(node.right, value)
 
 		// Found the node we wish to remove.
@@ -243,7 +268,7 @@ func (tree *AVLTree[T]) findMin(node *AVLNode[T]) T {
 	return node.value
 }
 
-// update update a node's height and balance factor.
+// update update a node's height, subtree size and balance factor.
 func (tree *AVLTree[T]) update(node *AVLNode[T]) {
 	leftNodeHeight := -1
 	if node.left != nil {
@@ -258,10 +283,21 @@ func (tree *AVLTree[T]) update(node *AVLNode[T]) {
 	// Update this node's height
 	node.height = 1 + gmath.Max(leftNodeHeight, rightNodeHeight)
 
+	// Update this node's subtree size
+	node.size = 1 + avlSize(node.left) + avlSize(node.right)
+
 	// Update balance factor
 	node.bf = rightNodeHeight - leftNodeHeight
 }
 
+// avlSize returns the number of nodes in the subtree, 0 for an empty subtree.
+func avlSize[T any](node *AVLNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
 // balance re-balance a node if its balance factor is +2 or -2.
 func (tree *AVLTree[T]) balance(node *AVLNode[T]) *AVLNode[T] {
 	// Left heavy subtree
@@ -483,9 +519,10 @@ func (tree *AVLTree[T]) search(node *AVLNode[T], value T) *AVLNode[T] {
 		return nil
 	}
 
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		return tree.search(node.left, value)
-	} else if value > node.value {
+	} else if cmp > 0 {
 		return tree.search(node.right, value)
 	}
 
@@ -511,15 +548,179 @@ func (tree *AVLTree[T]) contains(node *AVLNode[T], value T) bool {
 		return false
 	}
 
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		return tree.contains(node.left, value)
-	} else if value > node.value {
+	} else if cmp > 0 {
 		return tree.contains(node.right, value)
 	}
 
 	return true
 }
 
+// Floor returns the greatest value less than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *AVLTree[T]) Floor(value T) (T, bool) {
+	if node := tree.floor(value); node != nil {
+		return node.value, true
+	}
+	return utils.Zero[T](), false
+}
+
+// Ceiling returns the smallest value greater than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *AVLTree[T]) Ceiling(value T) (T, bool) {
+	if node := tree.ceiling(value); node != nil {
+		return node.value, true
+	}
+	return utils.Zero[T](), false
+}
+
+// Range calls the callback for the values between lo and hi inclusive in ascending order.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n + k), where 'k' is the number of values in the range.
+//
+// Space complexity: O(log n).
+func (tree *AVLTree[T]) Range(lo, hi T, callback func(value T)) {
+	tree.rangeValues(tree.root, lo, hi, callback)
+}
+
+func (tree *AVLTree[T]) rangeValues(node *AVLNode[T], lo, hi T, callback func(value T)) {
+	if node == nil {
+		return
+	}
+
+	afterLo := order(tree.compare, lo, node.value) <= 0
+	beforeHi := order(tree.compare, node.value, hi) <= 0
+
+	if afterLo {
+		tree.rangeValues(node.left, lo, hi, callback)
+	}
+	if afterLo && beforeHi {
+		callback(node.value)
+	}
+	if beforeHi {
+		tree.rangeValues(node.right, lo, hi, callback)
+	}
+}
+
+// Rank returns the number of values less than the given value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *AVLTree[T]) Rank(value T) int {
+	rank := 0
+	for node := tree.root; node != nil; {
+		if order(tree.compare, value, node.value) <= 0 {
+			node = node.left
+		} else {
+			rank += avlSize(node.left) + 1
+			node = node.right
+		}
+	}
+	return rank
+}
+
+// Select returns the k-th smallest value counting from 0, ok is false if k is out of range.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *AVLTree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= tree.size {
+		return utils.Zero[T](), false
+	}
+
+	node := tree.root
+	for {
+		left := avlSize(node.left)
+		if k < left {
+			node = node.left
+		} else if k > left {
+			k -= left + 1
+			node = node.right
+		} else {
+			return node.value, true
+		}
+	}
+}
+
+// floor returns the node with the greatest value less than or equal to the given value,
+// nil if there is no such node.
+func (tree *AVLTree[T]) floor(value T) *AVLNode[T] {
+	var floor *AVLNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node
+		} else if cmp < 0 {
+			node = node.left
+		} else {
+			floor = node
+			node = node.right
+		}
+	}
+	return floor
+}
+
+// ceiling returns the node with the smallest value greater than or equal to the given value,
+// nil if there is no such node.
+func (tree *AVLTree[T]) ceiling(value T) *AVLNode[T] {
+	var ceiling *AVLNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			ceiling = node
+			node = node.left
+		}
+	}
+	return ceiling
+}
+
+// traverseInorder visits the nodes in ascending order until the callback returns false,
+// it returns false if the traversal was stopped.
+func (tree *AVLTree[T]) traverseInorder(node *AVLNode[T], callback func(node *AVLNode[T]) bool) bool {
+	if node == nil {
+		return true
+	}
+	return tree.traverseInorder(node.left, callback) &&
+		callback(node) &&
+		tree.traverseInorder(node.right, callback)
+}
+
 // TraversePreorder traverses in preorder of traversal of a binary tree.
 //
 // DFS (Deep First Search) algorithm.
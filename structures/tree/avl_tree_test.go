@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/dkhrunov/dsa-go/structures/queue"
+	"github.com/dkhrunov/dsa-go/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,6 +29,40 @@ func TestNewAVLTree(t *testing.T) {
 	require.Zero(t, avl.size)
 }
 
+func TestNewAVLTreeWithComparator(t *testing.T) {
+	t.Parallel()
+	// Longer strings first, equal lengths in reverse lexicographic order.
+	avl := NewAVLTreeWithComparator(func(a, b string) int8 {
+		if len(a) != len(b) {
+			return utils.GreaterComparator(len(a), len(b))
+		}
+		return utils.GreaterComparator(a, b)
+	})
+
+	for _, s := range []string{"a", "ccc", "bb", "b", "aaa", "dddd"} {
+		require.True(t, avl.Insert(s))
+	}
+	require.False(t, avl.Insert("bb"))
+	require.Equal(t, 6, avl.Size())
+	require.True(t, isBalance(t, avl.Root()))
+
+	var values []string
+	var inorder func(node *AVLNode[string])
+	inorder = func(node *AVLNode[string]) {
+		if node != nil {
+			inorder(node.left)
+			values = append(values, node.value)
+			inorder(node.right)
+		}
+	}
+	inorder(avl.Root())
+	require.Equal(t, []string{"dddd", "ccc", "aaa", "bb", "b", "a"}, values)
+
+	require.True(t, avl.Delete("ccc"))
+	require.False(t, avl.Contains("ccc"))
+	require.NotNil(t, avl.Search("aaa"))
+}
+
 func TestAVLTreeRoot(t *testing.T) {
 	t.Parallel()
 
@@ -46,15 +81,14 @@ func TestAVLTreeRoot(t *testing.T) {
 
 func TestAVLTreeSize(t *testing.T) {
 	t.Parallel()
-	type TreeFactory func(t *testing.T) *AVLTree[int]
 	tests := []struct {
 		name   string
-		treeFc TreeFactory
+		treeFc treeFactory[*AVLTree[int]]
 		want   int
 	}{
 		{
 			name:   "should return zero size of avl",
-			treeFc: func(t *testing.T) *AVLTree[int] { t.Helper(); return &AVLTree[int]{} },
+			treeFc: treeOf(NewAVLTree[int]),
 			want:   0,
 		},
 		{
@@ -75,15 +109,14 @@ func TestAVLTreeSize(t *testing.T) {
 
 func TestAVLTreeHeight(t *testing.T) {
 	t.Parallel()
-	type TreeFactory func(t *testing.T) *AVLTree[int]
 	tests := []struct {
 		name   string
-		treeFc TreeFactory
+		treeFc treeFactory[*AVLTree[int]]
 		want   int
 	}{
 		{
 			name:   "should return 0 height for empty tree",
-			treeFc: func(t *testing.T) *AVLTree[int] { t.Helper(); return &AVLTree[int]{} },
+			treeFc: treeOf(NewAVLTree[int]),
 			want:   0,
 		},
 		{
@@ -186,10 +219,9 @@ func TestAVLTreeInsert(t *testing.T) {
 
 func TestAVLTreeDelete(t *testing.T) {
 	t.Parallel()
-	type TreeFactory func(t *testing.T) *AVLTree[int]
 	tests := []struct {
 		name       string
-		treeFc     TreeFactory
+		treeFc     treeFactory[*AVLTree[int]]
 		delete     int
 		size       int
 		serialized string
@@ -245,7 +277,7 @@ func TestAVLTreeDelete(t *testing.T) {
 		},
 		{
 			name:       "should not delete: Where Root is nil",
-			treeFc:     func(t *testing.T) *AVLTree[int] { t.Helper(); return NewAVLTree[int]() },
+			treeFc:     treeOf(NewAVLTree[int]),
 			delete:     1,
 			size:       0,
 			serialized: "",
@@ -322,7 +354,7 @@ func TestAVLTreeContains(t *testing.T) {
 	}
 }
 
-func createAVLTree(t *testing.T) *AVLTree[int] {
+func createAVLTree(t testing.TB) *AVLTree[int] {
 	t.Helper()
 	avl := &AVLTree[int]{}
 	avl.Insert(8)
@@ -335,14 +367,14 @@ func createAVLTree(t *testing.T) *AVLTree[int] {
 	return avl
 }
 
-func isBalance(t *testing.T, root *AVLNode[int]) bool {
+func isBalance[T any](t *testing.T, root *AVLNode[T]) bool {
 	if root == nil {
 		return true
 	}
 
 	balanced := true
 
-	checkNodeBf := func(n *AVLNode[int]) {
+	checkNodeBf := func(n *AVLNode[T]) {
 		if n.bf <= -2 || n.bf >= 2 {
 			balanced = false
 		}
@@ -353,7 +385,7 @@ func isBalance(t *testing.T, root *AVLNode[int]) bool {
 	return balanced
 }
 
-func traverseLevelorder(t *testing.T, root *AVLNode[int], cb func(node *AVLNode[int])) {
+func traverseLevelorder[T any](t *testing.T, root *AVLNode[T], cb func(node *AVLNode[T])) {
 	if root == nil {
 		return
 	}
@@ -362,7 +394,7 @@ func traverseLevelorder(t *testing.T, root *AVLNode[int], cb func(node *AVLNode[
 	queue.EnQueue(root)
 
 	for queue.Len() > 0 {
-		n := queue.DeQueue().(*AVLNode[int])
+		n := queue.DeQueue().(*AVLNode[T])
 		cb(n)
 
 		if n.Left() != nil {
@@ -374,3 +406,14 @@ func traverseLevelorder(t *testing.T, root *AVLNode[int], cb func(node *AVLNode[
 		}
 	}
 }
+
+func TestAVLTreeOrderStatistics(t *testing.T) {
+	t.Parallel()
+	avl := NewAVLTree[int]()
+	requireOrderStatistics(t, avl)
+
+	require.True(t, isBalance(t, avl.Root()))
+	traverseLevelorder(t, avl.Root(), func(node *AVLNode[int]) {
+		require.Equal(t, 1+avlSize(node.left)+avlSize(node.right), node.size)
+	})
+}
@@ -791,6 +791,21 @@ func traverseEmptyFactory(t *testing.T, sb *strings.Builder) func() {
 	}
 }
 
+// treeFactory creates the tree a test or a benchmark starts with.
+type treeFactory[Tree any] func(tb testing.TB) Tree
+
+// treeOf returns the factory of the tree created by newTree with the values inserted in the given order.
+func treeOf[Tree interface{ Insert(value int) bool }](newTree func() Tree, values ...int) treeFactory[Tree] {
+	return func(tb testing.TB) Tree {
+		tb.Helper()
+		tree := newTree()
+		for _, value := range values {
+			tree.Insert(value)
+		}
+		return tree
+	}
+}
+
 func newFullBinaryTree(t *testing.T) *BinaryNode[int] {
 	t.Helper()
 	bt := NewBinaryTree(1)
@@ -0,0 +1,517 @@
+package tree
+
+import (
+	"github.com/dkhrunov/dsa-go/gmath"
+	"github.com/dkhrunov/dsa-go/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// bplusNode is a node of BPlusTree, internal nodes hold only keys which separate their children,
+// the keys of the i-th child are less than keys[i] and not less than keys[i-1].
+// Leaves hold the keys with their values and are linked in ascending order.
+type bplusNode[K, V any] struct {
+	keys     []K
+	values   []V
+	children []*bplusNode[K, V]
+	next     *bplusNode[K, V]
+}
+
+func (node *bplusNode[K, V]) leaf() bool {
+	return len(node.children) == 0
+}
+
+// BPlusTree is a B+ tree map, the values are stored in the leaves which are linked together,
+// so range scans walk the leaves without going back up the tree.
+// Every node except the root holds from t-1 to 2t-1 keys, where t is the minimum degree,
+// and all leaves are at the same depth.
+//
+// The zero value is an empty tree of minimum degree MinDegree, which orders keys with the built-in operators,
+// inserting into it panics unless K is a predeclared integer, float or string type.
+type BPlusTree[K, V any] struct {
+	// Tracks the number of keys inside the tree.
+	size int
+	// The minimum degree of the tree.
+	t    int
+	root *bplusNode[K, V]
+	// Orders the keys of the tree.
+	compare utils.ComparatorFn[K]
+}
+
+// NewBPlusTree creates a new B+ tree with ordered keys,
+// a minimum degree less than MinDegree is replaced with MinDegree.
+func NewBPlusTree[K constraints.Ordered, V any](minDegree int) *BPlusTree[K, V] {
+	return NewBPlusTreeWithComparator[K, V](minDegree, utils.LessComparator[K])
+}
+
+// NewBPlusTreeWithComparator creates a new B+ tree with keys ordered by the comparator,
+// a minimum degree less than MinDegree is replaced with MinDegree.
+// See NewBSTWithComparator for the contract of the comparator.
+func NewBPlusTreeWithComparator[K, V any](minDegree int, compare utils.ComparatorFn[K]) *BPlusTree[K, V] {
+	return &BPlusTree[K, V]{t: gmath.Max(minDegree, MinDegree), compare: compare}
+}
+
+// lazyInit sets the minimum degree and the built-in order of a zero-value tree before its first key is added,
+// the comparator isn't used while the tree is empty.
+func (tree *BPlusTree[K, V]) lazyInit() {
+	if tree.t == 0 {
+		tree.t = MinDegree
+	}
+	if tree.compare == nil {
+		tree.compare = orderedComparator[K]()
+	}
+}
+
+// Size returns the number of keys in the tree.
+func (tree *BPlusTree[K, V]) Size() int {
+	return tree.size
+}
+
+// MinDegree returns the minimum degree of the tree.
+func (tree *BPlusTree[K, V]) MinDegree() int {
+	return gmath.Max(tree.t, MinDegree)
+}
+
+// Height the height of a rooted tree is the number of edges between the tree's
+// root and its leaves, a tree containing a single node has a height of 0.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Height() int {
+	height := 0
+	for node := tree.root; node != nil && !node.leaf(); node = node.children[0] {
+		height++
+	}
+	return height
+}
+
+// findLeaf returns the leaf which holds the key if it's present, nil if the tree is empty.
+func (tree *BPlusTree[K, V]) findLeaf(key K) *bplusNode[K, V] {
+	node := tree.root
+	for node != nil && !node.leaf() {
+		node = node.children[tree.childIndex(node, key)]
+	}
+	return node
+}
+
+// childIndex returns the index of the child of the internal node which covers the key.
+func (tree *BPlusTree[K, V]) childIndex(node *bplusNode[K, V], key K) int {
+	i, found := searchKeys(node.keys, key, tree.compare)
+	if found {
+		// The separator is the smallest key of the right child.
+		i++
+	}
+	return i
+}
+
+// Get returns the value of the key and whether the key is present.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Get(key K) (V, bool) {
+	leaf := tree.findLeaf(key)
+	if leaf == nil {
+		return utils.Zero[V](), false
+	}
+
+	i, found := searchKeys(leaf.keys, key, tree.compare)
+	if !found {
+		return utils.Zero[V](), false
+	}
+	return leaf.values[i], true
+}
+
+// Contains checks for the presence of the key in the tree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Contains(key K) bool {
+	_, ok := tree.Get(key)
+	return ok
+}
+
+// Put sets the value of the key, it returns true if the key was added and false if its value was replaced.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(t*log n), where 't' is the minimum degree.
+//
+// Space complexity: O(log n).
+func (tree *BPlusTree[K, V]) Put(key K, value V) bool {
+	tree.lazyInit()
+	if tree.root == nil {
+		tree.root = &bplusNode[K, V]{}
+	}
+
+	separator, right, added := tree.insert(tree.root, key, value)
+	if right != nil {
+		tree.root = &bplusNode[K, V]{
+			keys:     []K{separator},
+			children: []*bplusNode[K, V]{tree.root, right},
+		}
+	}
+
+	if added {
+		tree.size++
+	}
+	return added
+}
+
+// insert inserts the key into the subtree, if the node overflows it's split in two
+// and the new right node is returned with the separator to insert into the parent.
+func (tree *BPlusTree[K, V]) insert(node *bplusNode[K, V], key K, value V) (K, *bplusNode[K, V], bool) {
+	t := tree.t
+
+	if node.leaf() {
+		i, found := searchKeys(node.keys, key, tree.compare)
+		if found {
+			node.values[i] = value
+			return utils.Zero[K](), nil, false
+		}
+
+		node.keys = insertAt(node.keys, i, key)
+		node.values = insertAt(node.values, i, value)
+		if len(node.keys) < 2*t {
+			return utils.Zero[K](), nil, true
+		}
+
+		// Split the leaf in halves of t keys, the right half starts with the separator.
+		right := &bplusNode[K, V]{
+			keys:   append([]K(nil), node.keys[t:]...),
+			values: append([]V(nil), node.values[t:]...),
+			next:   node.next,
+		}
+		node.keys, node.values = node.keys[:t], node.values[:t]
+		node.next = right
+		return right.keys[0], right, true
+	}
+
+	i := tree.childIndex(node, key)
+	separator, child, added := tree.insert(node.children[i], key, value)
+	if child == nil {
+		return utils.Zero[K](), nil, added
+	}
+
+	node.keys = insertAt(node.keys, i, separator)
+	node.children = insertAt(node.children, i+1, child)
+	if len(node.keys) < 2*t {
+		return utils.Zero[K](), nil, added
+	}
+
+	// Split the internal node around its middle key, which moves up to the parent.
+	separator = node.keys[t]
+	right := &bplusNode[K, V]{
+		keys:     append([]K(nil), node.keys[t+1:]...),
+		children: append([]*bplusNode[K, V](nil), node.children[t+1:]...),
+	}
+	node.keys, node.children = node.keys[:t], node.children[:t+1]
+	return separator, right, added
+}
+
+// Delete removes the key from the tree, it returns false if the key is not present.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(t*log n), where 't' is the minimum degree.
+//
+// Space complexity: O(log n).
+func (tree *BPlusTree[K, V]) Delete(key K) bool {
+	if tree.root == nil || !tree.delete(tree.root, key) {
+		return false
+	}
+	tree.size--
+
+	if tree.root.leaf() && len(tree.root.keys) == 0 {
+		tree.root = nil
+	} else if !tree.root.leaf() && len(tree.root.keys) == 0 {
+		tree.root = tree.root.children[0]
+	}
+	return true
+}
+
+// delete deletes the key from the subtree and refills the child it was deleted from if it underflows.
+func (tree *BPlusTree[K, V]) delete(node *bplusNode[K, V], key K) bool {
+	if node.leaf() {
+		i, found := searchKeys(node.keys, key, tree.compare)
+		if found {
+			node.keys = removeAt(node.keys, i)
+			node.values = removeAt(node.values, i)
+		}
+		return found
+	}
+
+	i := tree.childIndex(node, key)
+	if !tree.delete(node.children[i], key) {
+		return false
+	}
+
+	if len(node.children[i].keys) < tree.t-1 {
+		tree.fill(node, i)
+	}
+	return true
+}
+
+// fill refills the underflowed i-th child of the node by borrowing a key from a sibling or merging with it.
+func (tree *BPlusTree[K, V]) fill(node *bplusNode[K, V], i int) {
+	t := tree.t
+	child := node.children[i]
+
+	if i > 0 && len(node.children[i-1].keys) >= t {
+		left := node.children[i-1]
+		last := len(left.keys) - 1
+		if child.leaf() {
+			// Move the last entry of the left sibling, it becomes the smallest key of the child.
+			child.keys = insertAt(child.keys, 0, left.keys[last])
+			child.values = insertAt(child.values, 0, left.values[last])
+			left.keys, left.values = left.keys[:last], left.values[:last]
+			node.keys[i-1] = child.keys[0]
+		} else {
+			// Rotate the last key of the left sibling through the node.
+			child.keys = insertAt(child.keys, 0, node.keys[i-1])
+			child.children = insertAt(child.children, 0, left.children[last+1])
+			node.keys[i-1] = left.keys[last]
+			left.keys, left.children = left.keys[:last], left.children[:last+1]
+		}
+		return
+	}
+
+	if i < len(node.children)-1 && len(node.children[i+1].keys) >= t {
+		right := node.children[i+1]
+		if child.leaf() {
+			// Move the first entry of the right sibling, its next key becomes the separator.
+			child.keys = append(child.keys, right.keys[0])
+			child.values = append(child.values, right.values[0])
+			right.keys, right.values = removeAt(right.keys, 0), removeAt(right.values, 0)
+			node.keys[i] = right.keys[0]
+		} else {
+			// Rotate the first key of the right sibling through the node.
+			child.keys = append(child.keys, node.keys[i])
+			child.children = append(child.children, right.children[0])
+			node.keys[i] = right.keys[0]
+			right.keys, right.children = removeAt(right.keys, 0), removeAt(right.children, 0)
+		}
+		return
+	}
+
+	if i < len(node.children)-1 {
+		tree.merge(node, i)
+	} else {
+		tree.merge(node, i-1)
+	}
+}
+
+// merge merges the (i+1)-th child of the node into the i-th child.
+func (tree *BPlusTree[K, V]) merge(node *bplusNode[K, V], i int) {
+	left, right := node.children[i], node.children[i+1]
+
+	if left.leaf() {
+		left.keys = append(left.keys, right.keys...)
+		left.values = append(left.values, right.values...)
+		left.next = right.next
+	} else {
+		// The separator moves down between the keys of the merged children.
+		left.keys = append(append(left.keys, node.keys[i]), right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+
+	node.keys = removeAt(node.keys, i)
+	node.children = removeAt(node.children, i+1)
+}
+
+// Min returns the smallest key with its value, ok is false if the tree is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Min() (K, V, bool) {
+	leaf := tree.firstLeaf()
+	if leaf == nil {
+		return utils.Zero[K](), utils.Zero[V](), false
+	}
+	return leaf.keys[0], leaf.values[0], true
+}
+
+// Max returns the greatest key with its value, ok is false if the tree is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Max() (K, V, bool) {
+	if tree.root == nil {
+		return utils.Zero[K](), utils.Zero[V](), false
+	}
+
+	node := tree.root
+	for !node.leaf() {
+		node = node.children[len(node.children)-1]
+	}
+	last := len(node.keys) - 1
+	return node.keys[last], node.values[last], true
+}
+
+func (tree *BPlusTree[K, V]) firstLeaf() *bplusNode[K, V] {
+	node := tree.root
+	for node != nil && !node.leaf() {
+		node = node.children[0]
+	}
+	return node
+}
+
+// Range calls the callback for the keys between lo and hi inclusive with their values in ascending order.
+//
+// The leaf holding lo is found once, then the scan follows the links between the leaves.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n + k), where 'k' is the number of keys in the range.
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Range(lo, hi K, callback func(key K, value V)) {
+	leaf := tree.findLeaf(lo)
+	if leaf == nil {
+		return
+	}
+
+	i, _ := searchKeys(leaf.keys, lo, tree.compare)
+	tree.scan(leaf, i, func(key K, value V) bool {
+		if order(tree.compare, key, hi) > 0 {
+			return false
+		}
+		callback(key, value)
+		return true
+	})
+}
+
+// Traverse visits the keys with their values in ascending order until the callback returns false.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(1).
+func (tree *BPlusTree[K, V]) Traverse(callback func(key K, value V) bool) {
+	if leaf := tree.firstLeaf(); leaf != nil {
+		tree.scan(leaf, 0, callback)
+	}
+}
+
+// scan visits the entries of the linked leaves starting from the i-th entry of the leaf
+// until the callback returns false.
+func (tree *BPlusTree[K, V]) scan(leaf *bplusNode[K, V], i int, callback func(key K, value V) bool) {
+	for ; leaf != nil; leaf, i = leaf.next, 0 {
+		for ; i < len(leaf.keys); i++ {
+			if !callback(leaf.keys[i], leaf.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// BulkLoad replaces the content of the tree with the keys and their values,
+// the keys must be sorted in ascending order without duplicates.
+//
+// The leaves are filled from the sorted input and linked, then each internal level is built
+// over the level below it, the keys are spread evenly between the nodes of each level.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(n).
+func (tree *BPlusTree[K, V]) BulkLoad(keys []K, values []V) error {
+	tree.lazyInit()
+	if err := checkSorted(keys, values, tree.compare); err != nil {
+		return err
+	}
+
+	tree.size = len(keys)
+	if len(keys) == 0 {
+		tree.root = nil
+		return nil
+	}
+
+	maxKeys := 2*tree.t - 1
+
+	// Leaves, each with the smallest key of its subtree.
+	leaves := (len(keys) + maxKeys - 1) / maxKeys
+	level := make([]*bplusNode[K, V], 0, leaves)
+	minKeys := make([]K, 0, leaves)
+	for _, r := range evenSplit(len(keys), leaves) {
+		leaf := &bplusNode[K, V]{
+			keys:   append([]K(nil), keys[r[0]:r[1]]...),
+			values: append([]V(nil), values[r[0]:r[1]]...),
+		}
+		if len(level) > 0 {
+			level[len(level)-1].next = leaf
+		}
+		level = append(level, leaf)
+		minKeys = append(minKeys, leaf.keys[0])
+	}
+
+	// A node has at most 2t children, spreading them evenly between the fewest nodes
+	// gives every node at least t children.
+	for len(level) > 1 {
+		parents := (len(level) + 2*tree.t - 1) / (2 * tree.t)
+		nextLevel := make([]*bplusNode[K, V], 0, parents)
+		nextMinKeys := make([]K, 0, parents)
+		for _, r := range evenSplit(len(level), parents) {
+			nextLevel = append(nextLevel, &bplusNode[K, V]{
+				keys:     append([]K(nil), minKeys[r[0]+1:r[1]]...),
+				children: append([]*bplusNode[K, V](nil), level[r[0]:r[1]]...),
+			})
+			nextMinKeys = append(nextMinKeys, minKeys[r[0]])
+		}
+		level, minKeys = nextLevel, nextMinKeys
+	}
+
+	tree.root = level[0]
+	return nil
+}
+
+// evenSplit splits [0, n) into the given number of contiguous ranges which differ in length by at most one.
+func evenSplit(n, parts int) [][2]int {
+	ranges := make([][2]int, 0, parts)
+	size, extra := n/parts, n%parts
+	lo := 0
+	for p := 0; p < parts; p++ {
+		hi := lo + size
+		if p < extra {
+			hi++
+		}
+		ranges = append(ranges, [2]int{lo, hi})
+		lo = hi
+	}
+	return ranges
+}
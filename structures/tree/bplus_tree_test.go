@@ -0,0 +1,242 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBPlusTree(t *testing.T) {
+	t.Parallel()
+	tree := NewBPlusTree[int, string](4)
+
+	require.Equal(t, 4, tree.MinDegree())
+	require.Zero(t, tree.Size())
+	require.Zero(t, tree.Height())
+	require.Equal(t, MinDegree, NewBPlusTree[int, string](1).MinDegree())
+
+	_, ok := tree.Get(1)
+	require.False(t, ok)
+	require.False(t, tree.Delete(1))
+	_, _, ok = tree.Min()
+	require.False(t, ok)
+	_, _, ok = tree.Max()
+	require.False(t, ok)
+	tree.Range(0, 10, func(key int, value string) {
+		require.Fail(t, "empty tree")
+	})
+}
+
+func TestBPlusTreeZeroValue(t *testing.T) {
+	t.Parallel()
+	var tree BPlusTree[string, int]
+	require.Equal(t, MinDegree, tree.MinDegree())
+	require.NoError(t, tree.BulkLoad([]string{"a", "b", "c"}, []int{1, 2, 3}))
+	require.True(t, tree.Put("d", 4))
+
+	value, ok := tree.Get("b")
+	require.True(t, ok)
+	require.Equal(t, 2, value)
+	require.Equal(t, 4, tree.Size())
+}
+
+func TestBPlusTreePutGetDelete(t *testing.T) {
+	t.Parallel()
+	for _, degree := range []int{2, 3, 5} {
+		degree := degree
+		t.Run(strconv.Itoa(degree), func(t *testing.T) {
+			t.Parallel()
+			tree := NewBPlusTree[int, int](degree)
+			present := make(map[int]int)
+
+			rng := rand.New(rand.NewSource(int64(degree)))
+			for i := 0; i < 3000; i++ {
+				key := rng.Intn(500)
+				if rng.Intn(3) == 0 {
+					_, ok := present[key]
+					require.Equal(t, ok, tree.Delete(key), "delete %v", key)
+					delete(present, key)
+				} else {
+					_, ok := present[key]
+					require.Equal(t, !ok, tree.Put(key, i), "put %v", key)
+					present[key] = i
+				}
+
+				if i%100 == 0 {
+					requireBPlusTree(t, tree)
+				}
+			}
+
+			requireBPlusTree(t, tree)
+			require.Equal(t, len(present), tree.Size())
+			for key := 0; key < 500; key++ {
+				value, ok := tree.Get(key)
+				want, has := present[key]
+				require.Equal(t, has, ok)
+				require.Equal(t, want, value)
+			}
+
+			for key := range present {
+				require.True(t, tree.Delete(key))
+			}
+			require.Zero(t, tree.Size())
+			require.Nil(t, tree.root)
+		})
+	}
+}
+
+func TestBPlusTreeRangeTraverse(t *testing.T) {
+	t.Parallel()
+	tree := NewBPlusTree[int, string](2)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(50) {
+		tree.Put(key*2, string(rune('a'+key%26)))
+	}
+
+	tests := []struct {
+		lo, hi int
+		want   []int
+	}{
+		{lo: 15, hi: 31, want: []int{16, 18, 20, 22, 24, 26, 28, 30}},
+		{lo: 94, hi: 200, want: []int{94, 96, 98}},
+		{lo: -10, hi: 3, want: []int{0, 2}},
+		{lo: 99, hi: 200, want: nil},
+		{lo: 10, hi: 5, want: nil},
+	}
+
+	for _, tt := range tests {
+		var keys []int
+		tree.Range(tt.lo, tt.hi, func(key int, value string) {
+			keys = append(keys, key)
+			require.Equal(t, string(rune('a'+(key/2)%26)), value)
+		})
+		require.Equal(t, tt.want, keys, "range [%v, %v]", tt.lo, tt.hi)
+	}
+
+	var keys []int
+	tree.Traverse(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return len(keys) < 5
+	})
+	require.Equal(t, []int{0, 2, 4, 6, 8}, keys)
+
+	key, _, ok := tree.Min()
+	require.True(t, ok)
+	require.Equal(t, 0, key)
+	key, _, ok = tree.Max()
+	require.True(t, ok)
+	require.Equal(t, 98, key)
+}
+
+func TestBPlusTreeBulkLoad(t *testing.T) {
+	t.Parallel()
+	for _, degree := range []int{2, 3, 4} {
+		for n := 0; n <= 300; n++ {
+			keys := make([]int, n)
+			values := make([]string, n)
+			for i := range keys {
+				keys[i] = i * 3
+				values[i] = string(rune('a' + i%26))
+			}
+
+			tree := NewBPlusTree[int, string](degree)
+			tree.Put(-1, "replaced")
+			require.NoError(t, tree.BulkLoad(keys, values))
+			requireBPlusTree(t, tree)
+			require.Equal(t, n, tree.Size())
+			require.False(t, tree.Contains(-1))
+
+			got := make([]int, 0, n)
+			tree.Traverse(func(key int, value string) bool {
+				got = append(got, key)
+				return true
+			})
+			require.Equal(t, keys, got, "degree %v, n %v", degree, n)
+
+			// The loaded tree stays valid under mutations.
+			tree.Put(1, "x")
+			if n > 0 {
+				require.True(t, tree.Delete(keys[n/2]))
+			}
+			requireBPlusTree(t, tree)
+		}
+	}
+
+	tree := NewBPlusTree[int, int](2)
+	require.ErrorIs(t, tree.BulkLoad([]int{2, 1}, []int{1, 2}), ErrKeysNotSorted)
+	require.ErrorIs(t, tree.BulkLoad([]int{1, 2}, []int{1}), ErrKeysValuesMismatch)
+}
+
+// requireBPlusTree checks the key counts, the separators, the depth of the leaves
+// and the links between the leaves of the B+ tree.
+func requireBPlusTree[K, V any](t *testing.T, tree *BPlusTree[K, V]) {
+	t.Helper()
+	if tree.root == nil {
+		require.Zero(t, tree.size)
+		return
+	}
+
+	less := func(keys []K) func(i, j int) bool {
+		return func(i, j int) bool {
+			return order(tree.compare, keys[i], keys[j]) < 0
+		}
+	}
+
+	var leaves []*bplusNode[K, V]
+	leafDepth := -1
+	// check returns the smallest and the greatest keys of the subtree.
+	var check func(node *bplusNode[K, V], depth int) (K, K)
+	check = func(node *bplusNode[K, V], depth int) (K, K) {
+		if node != tree.root {
+			require.GreaterOrEqual(t, len(node.keys), tree.t-1, "underflow")
+		}
+		require.LessOrEqual(t, len(node.keys), 2*tree.t-1, "overflow")
+		require.True(t, sort.SliceIsSorted(node.keys, less(node.keys)))
+
+		if node.leaf() {
+			require.NotEmpty(t, node.keys)
+			require.Len(t, node.values, len(node.keys))
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			require.Equal(t, leafDepth, depth, "leaves at different depths")
+			leaves = append(leaves, node)
+			return node.keys[0], node.keys[len(node.keys)-1]
+		}
+
+		require.NotEmpty(t, node.keys)
+		require.Empty(t, node.values)
+		require.Len(t, node.children, len(node.keys)+1)
+
+		var lo, hi K
+		for i, child := range node.children {
+			childLo, childHi := check(child, depth+1)
+			if i == 0 {
+				lo = childLo
+			}
+			if i > 0 {
+				require.LessOrEqual(t, order(tree.compare, node.keys[i-1], childLo), 0, "key below its separator")
+			}
+			if i < len(node.keys) {
+				require.Negative(t, order(tree.compare, childHi, node.keys[i]), "key above its separator")
+			}
+			hi = childHi
+		}
+		return lo, hi
+	}
+	check(tree.root, 0)
+	require.Equal(t, leafDepth, tree.Height())
+
+	count := 0
+	for i, leaf := range leaves {
+		count += len(leaf.keys)
+		if i < len(leaves)-1 {
+			require.Same(t, leaves[i+1], leaf.next, "broken leaf link")
+		} else {
+			require.Nil(t, leaf.next)
+		}
+	}
+	require.Equal(t, tree.size, count)
+}
@@ -1,19 +1,37 @@
 package tree
 
 import (
+	"github.com/dkhrunov/dsa-go/utils"
 	"golang.org/x/exp/constraints"
 )
 
-type BSTree[T constraints.Ordered] struct {
-	size int
-	root *BinaryNode[T]
+// BSTree is a binary search tree.
+// The zero value is an empty tree, which orders values with the built-in operators,
+// inserting into it panics unless T is a predeclared integer, float or string type.
+type BSTree[T any] struct {
+	size    int
+	root    *BinaryNode[T]
+	compare utils.ComparatorFn[T]
 }
 
-// TODO add comparator to constructor and use it in comparisons
-
-// NewBST creates a new binary search tree.
+// NewBST creates a new binary search tree of ordered values.
 func NewBST[T constraints.Ordered]() *BSTree[T] {
-	return &BSTree[T]{size: 0, root: nil}
+	return NewBSTWithComparator(utils.LessComparator[T])
+}
+
+// NewBSTWithComparator creates a new binary search tree ordered by the comparator,
+// compare returns 1 if a goes before b, -1 if b goes before a and 0 if they are equal,
+// e.g. utils.LessComparator and utils.GreaterComparator order values ascending and descending.
+func NewBSTWithComparator[T any](compare utils.ComparatorFn[T]) *BSTree[T] {
+	return &BSTree[T]{size: 0, root: nil, compare: compare}
+}
+
+// lazyInit sets the built-in order of a zero-value tree before its first value is added,
+// the comparator isn't used while the tree is empty.
+func (tree *BSTree[T]) lazyInit() {
+	if tree.compare == nil {
+		tree.compare = orderedComparator[T]()
+	}
 }
 
 // Size returns the size of the BST.
@@ -26,6 +44,23 @@ func (tree *BSTree[T]) Root() *BinaryNode[T] {
 	return tree.root
 }
 
+// Height the height of a rooted tree is the number of edges between the tree's
+// root and its furthest leaf, a tree containing a single node has a height of 0.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(h), where 'h' is the height of tree.
+func (tree *BSTree[T]) Height() int {
+	if tree.root == nil {
+		return 0
+	}
+	return MaxDepth(tree.root) - 1
+}
+
 // Min gets the minimum value.
 //
 // --------------------------------------------------
@@ -63,6 +98,7 @@ func (tree *BSTree[T]) Max() T {
 // Space complexity: O(h), where 'h' is the height of tree, if we do consider the stack size for function calls.
 // Otherwise, the space complexity of inorder traversal is O(1).
 func (tree *BSTree[T]) Insert(value T) bool {
+	tree.lazyInit()
 	if tree.contains(tree.Root(), value) {
 		return false
 	}
@@ -82,9 +118,10 @@ func (tree *BSTree[T]) insert(node, parent *BinaryNode[T], value T) *BinaryNode[
 		}
 	}
 
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		node.left = tree.insert(node.left, node, value)
-	} else if value > node.value {
+	} else if cmp > 0 {
 		node.right = tree.insert(node.right, node, value)
 	}
 
@@ -106,7 +143,7 @@ func (tree *BSTree[T]) Delete(value T) bool {
 		return false
 	}
 
-	tree.delete(tree.root, value)
+	tree.root = tree.delete(tree.root, value)
 	tree.size--
 	return true
 }
@@ -119,12 +156,13 @@ func (tree *BSTree[T]) delete(node *BinaryNode[T], value T) *BinaryNode[T] {
 
 	// Dig into left subtree, the value we're looking
 	// for is smaller than the current value.
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		node.left = tree.delete(node.left, value)
 
 		// Dig into right subtree, the value we're looking
 		// for is greater than the current value.
-	} else if value > node.value {
+	} else if cmp > 0 {
 		node.right = tree.delete(node.right, value)
 
 		// Found the node we wish to remove.
@@ -169,9 +207,10 @@ func (tree *BSTree[T]) search(node *BinaryNode[T], value T) *BinaryNode[T] {
 		return nil
 	}
 
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		return tree.search(node.left, value)
-	} else if value > node.value {
+	} else if cmp > 0 {
 		return tree.search(node.right, value)
 	}
 
@@ -197,11 +236,164 @@ func (tree *BSTree[T]) contains(node *BinaryNode[T], value T) bool {
 		return false
 	}
 
-	if value < node.value {
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
 		return tree.contains(node.left, value)
-	} else if value > node.value {
+	} else if cmp > 0 {
 		return tree.contains(node.right, value)
 	}
 
 	return true
 }
+
+// Floor returns the greatest value less than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(h), where 'h' is the height of tree.
+//
+// Space complexity: O(1).
+func (tree *BSTree[T]) Floor(value T) (T, bool) {
+	var floor *BinaryNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node.value, true
+		} else if cmp < 0 {
+			node = node.left
+		} else {
+			floor = node
+			node = node.right
+		}
+	}
+
+	if floor == nil {
+		return utils.Zero[T](), false
+	}
+	return floor.value, true
+}
+
+// Ceiling returns the smallest value greater than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(h), where 'h' is the height of tree.
+//
+// Space complexity: O(1).
+func (tree *BSTree[T]) Ceiling(value T) (T, bool) {
+	var ceiling *BinaryNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node.value, true
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			ceiling = node
+			node = node.left
+		}
+	}
+
+	if ceiling == nil {
+		return utils.Zero[T](), false
+	}
+	return ceiling.value, true
+}
+
+// Range calls the callback for the values between lo and hi inclusive in ascending order.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(h + k), where 'h' is the height of tree and 'k' is the number of values in the range.
+//
+// Space complexity: O(h), where 'h' is the height of tree.
+func (tree *BSTree[T]) Range(lo, hi T, callback func(value T)) {
+	tree.rangeValues(tree.root, lo, hi, callback)
+}
+
+func (tree *BSTree[T]) rangeValues(node *BinaryNode[T], lo, hi T, callback func(value T)) {
+	if node == nil {
+		return
+	}
+
+	afterLo := order(tree.compare, lo, node.value) <= 0
+	beforeHi := order(tree.compare, node.value, hi) <= 0
+
+	if afterLo {
+		tree.rangeValues(node.left, lo, hi, callback)
+	}
+	if afterLo && beforeHi {
+		callback(node.value)
+	}
+	if beforeHi {
+		tree.rangeValues(node.right, lo, hi, callback)
+	}
+}
+
+// Rank returns the number of values less than the given value.
+//
+// The BST doesn't keep subtree sizes, so the smaller values are counted one by one.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(h + r), where 'h' is the height of tree and 'r' is the rank.
+//
+// Space complexity: O(h), where 'h' is the height of tree.
+func (tree *BSTree[T]) Rank(value T) int {
+	return tree.rank(tree.root, value)
+}
+
+func (tree *BSTree[T]) rank(node *BinaryNode[T], value T) int {
+	if node == nil {
+		return 0
+	}
+
+	if order(tree.compare, value, node.value) <= 0 {
+		return tree.rank(node.left, value)
+	}
+	return tree.rank(node.left, value) + 1 + tree.rank(node.right, value)
+}
+
+// Select returns the k-th smallest value counting from 0, ok is false if k is out of range.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(h + k), where 'h' is the height of tree.
+//
+// Space complexity: O(h), where 'h' is the height of tree.
+func (tree *BSTree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= tree.size {
+		return utils.Zero[T](), false
+	}
+
+	var selected *BinaryNode[T]
+	var inorder func(node *BinaryNode[T])
+	inorder = func(node *BinaryNode[T]) {
+		if node == nil || selected != nil {
+			return
+		}
+		inorder(node.left)
+		if selected == nil {
+			if k == 0 {
+				selected = node
+			}
+			k--
+		}
+		inorder(node.right)
+	}
+	inorder(tree.root)
+
+	return selected.value, true
+}
@@ -1,8 +1,11 @@
 package tree
 
 import (
+	"math/rand"
+	"sort"
 	"testing"
 
+	"github.com/dkhrunov/dsa-go/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,6 +18,53 @@ func TestNewBST(t *testing.T) {
 	require.Zero(t, bst.size)
 }
 
+func TestNewBSTWithComparator(t *testing.T) {
+	t.Parallel()
+	type user struct {
+		id   int
+		name string
+	}
+	bst := NewBSTWithComparator(func(a, b user) int8 {
+		return utils.LessComparator(a.id, b.id)
+	})
+
+	for _, u := range []user{{3, "c"}, {1, "a"}, {2, "b"}} {
+		require.True(t, bst.Insert(u))
+	}
+	require.False(t, bst.Insert(user{id: 2, name: "duplicate"}))
+	require.Equal(t, 3, bst.Size())
+	require.Equal(t, user{1, "a"}, bst.Min())
+	require.Equal(t, user{3, "c"}, bst.Max())
+	require.Equal(t, "b", bst.Search(user{id: 2}).value.name)
+
+	require.True(t, bst.Delete(user{id: 3}))
+	require.False(t, bst.Contains(user{id: 3}))
+	require.Equal(t, user{2, "b"}, bst.Max())
+}
+
+func TestBSTZeroValue(t *testing.T) {
+	t.Parallel()
+	bst := &BSTree[float64]{}
+	require.False(t, bst.Contains(7))
+	for _, v := range []float64{20.5, -3, 7} {
+		require.True(t, bst.Insert(v))
+	}
+	require.False(t, bst.Insert(7))
+	require.Equal(t, -3.0, bst.Min())
+	require.Equal(t, 20.5, bst.Max())
+	require.True(t, bst.Delete(-3))
+	require.False(t, bst.Contains(-3))
+
+	// Types without a built-in order, including named types, need a comparator.
+	type celsius float64
+	require.Panics(t, func() {
+		(&BSTree[celsius]{}).Insert(20.5)
+	})
+	require.Panics(t, func() {
+		(&BSTree[struct{}]{}).Insert(struct{}{})
+	})
+}
+
 func TestBSTSize(t *testing.T) {
 	t.Parallel()
 
@@ -141,10 +191,9 @@ func TestBSTInsert(t *testing.T) {
 
 func TestBSTDelete(t *testing.T) {
 	t.Parallel()
-	type TreeFactory func(t *testing.T) *BSTree[int]
 	tests := []struct {
 		name       string
-		treeFc     TreeFactory
+		treeFc     treeFactory[*BSTree[int]]
 		delete     int
 		serialized string
 		size       int
@@ -174,6 +223,22 @@ func TestBSTDelete(t *testing.T) {
 			size:       6,
 			success:    true,
 		},
+		{
+			name:       "should delete root with single child node",
+			treeFc:     treeOf(NewBST[int], 1, 2),
+			delete:     1,
+			serialized: "^2,#,#,",
+			size:       1,
+			success:    true,
+		},
+		{
+			name:       "should delete the only node",
+			treeFc:     treeOf(NewBST[int], 1),
+			delete:     1,
+			serialized: "",
+			size:       0,
+			success:    true,
+		},
 		{
 			name:       "should do nothing with not existing value",
 			treeFc:     createBST,
@@ -184,7 +249,7 @@ func TestBSTDelete(t *testing.T) {
 		},
 		{
 			name:       "should not delete: Where Root is nil",
-			treeFc:     func(t *testing.T) *BSTree[int] { t.Helper(); return NewBST[int]() },
+			treeFc:     treeOf(NewBST[int]),
 			delete:     1,
 			serialized: "",
 			size:       0,
@@ -261,7 +326,7 @@ func TestBSTContains(t *testing.T) {
 	}
 }
 
-func createBST(t *testing.T) *BSTree[int] {
+func createBST(t testing.TB) *BSTree[int] {
 	t.Helper()
 	bst := &BSTree[int]{}
 	bst.root = &BinaryNode[int]{value: 8}
@@ -274,3 +339,75 @@ func createBST(t *testing.T) *BSTree[int] {
 	bst.size = 7
 	return bst
 }
+
+// requireOrderStatistics checks the queries of the tree filled with random even values against a sorted slice.
+func requireOrderStatistics(t *testing.T, tree OrderedSet[int]) {
+	t.Helper()
+
+	rng := rand.New(rand.NewSource(1))
+	var values []int
+	for _, i := range rng.Perm(200) {
+		tree.Insert(i * 2)
+		values = append(values, i*2)
+	}
+	// Delete every third value, so the tree gets rebalanced
+	for i := 0; i < 200; i += 3 {
+		require.True(t, tree.Delete(i*2))
+	}
+	kept := values[:0]
+	for _, v := range values {
+		if (v/2)%3 != 0 {
+			kept = append(kept, v)
+		}
+	}
+	sort.Ints(kept)
+
+	for v := -1; v <= 400; v++ {
+		rank := sort.SearchInts(kept, v)
+		require.Equal(t, rank, tree.Rank(v), "rank of %v", v)
+
+		floor, ok := tree.Floor(v)
+		if i := sort.SearchInts(kept, v+1) - 1; i >= 0 {
+			require.True(t, ok)
+			require.Equal(t, kept[i], floor, "floor of %v", v)
+		} else {
+			require.False(t, ok)
+		}
+
+		ceiling, ok := tree.Ceiling(v)
+		if rank < len(kept) {
+			require.True(t, ok)
+			require.Equal(t, kept[rank], ceiling, "ceiling of %v", v)
+		} else {
+			require.False(t, ok)
+		}
+	}
+
+	for k, want := range kept {
+		got, ok := tree.Select(k)
+		require.True(t, ok)
+		require.Equal(t, want, got)
+	}
+	_, ok := tree.Select(-1)
+	require.False(t, ok)
+	_, ok = tree.Select(len(kept))
+	require.False(t, ok)
+
+	var inRange []int
+	tree.Range(101, 151, func(value int) {
+		inRange = append(inRange, value)
+	})
+	lo, hi := sort.SearchInts(kept, 101), sort.SearchInts(kept, 152)
+	require.Equal(t, kept[lo:hi], inRange)
+
+	inRange = nil
+	tree.Range(10, 5, func(value int) {
+		inRange = append(inRange, value)
+	})
+	require.Empty(t, inRange)
+}
+
+func TestBSTOrderStatistics(t *testing.T) {
+	t.Parallel()
+	requireOrderStatistics(t, NewBST[int]())
+}
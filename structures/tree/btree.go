@@ -0,0 +1,568 @@
+package tree
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/dkhrunov/dsa-go/gmath"
+	"github.com/dkhrunov/dsa-go/utils"
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrKeysNotSorted = errors.New("keys must be sorted in ascending order without duplicates")
+
+	ErrKeysValuesMismatch = errors.New("keys and values must have the same length")
+)
+
+// MinDegree is the smallest allowed minimum degree of BTree and BPlusTree.
+const MinDegree = 2
+
+type btreeNode[K, V any] struct {
+	keys     []K
+	values   []V
+	children []*btreeNode[K, V]
+}
+
+func (node *btreeNode[K, V]) leaf() bool {
+	return len(node.children) == 0
+}
+
+// BTree is a B-tree map, every node except the root holds from t-1 to 2t-1 keys,
+// where t is the minimum degree, and all leaves are at the same depth.
+//
+// The zero value is an empty tree of minimum degree MinDegree, which orders keys with the built-in operators,
+// inserting into it panics unless K is a predeclared integer, float or string type.
+type BTree[K, V any] struct {
+	// Tracks the number of keys inside the tree.
+	size int
+	// The minimum degree of the tree.
+	t    int
+	root *btreeNode[K, V]
+	// Orders the keys of the tree.
+	compare utils.ComparatorFn[K]
+}
+
+// NewBTree creates a new B-tree with ordered keys,
+// a minimum degree less than MinDegree is replaced with MinDegree.
+func NewBTree[K constraints.Ordered, V any](minDegree int) *BTree[K, V] {
+	return NewBTreeWithComparator[K, V](minDegree, utils.LessComparator[K])
+}
+
+// NewBTreeWithComparator creates a new B-tree with keys ordered by the comparator,
+// a minimum degree less than MinDegree is replaced with MinDegree.
+// See NewBSTWithComparator for the contract of the comparator.
+func NewBTreeWithComparator[K, V any](minDegree int, compare utils.ComparatorFn[K]) *BTree[K, V] {
+	return &BTree[K, V]{t: gmath.Max(minDegree, MinDegree), compare: compare}
+}
+
+// lazyInit sets the minimum degree and the built-in order of a zero-value tree before its first key is added,
+// the comparator isn't used while the tree is empty.
+func (tree *BTree[K, V]) lazyInit() {
+	if tree.t == 0 {
+		tree.t = MinDegree
+	}
+	if tree.compare == nil {
+		tree.compare = orderedComparator[K]()
+	}
+}
+
+// Size returns the number of keys in the tree.
+func (tree *BTree[K, V]) Size() int {
+	return tree.size
+}
+
+// MinDegree returns the minimum degree of the tree.
+func (tree *BTree[K, V]) MinDegree() int {
+	return gmath.Max(tree.t, MinDegree)
+}
+
+// Height the height of a rooted tree is the number of edges between the tree's
+// root and its leaves, a tree containing a single node has a height of 0.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BTree[K, V]) Height() int {
+	height := 0
+	for node := tree.root; node != nil && !node.leaf(); node = node.children[0] {
+		height++
+	}
+	return height
+}
+
+// Get returns the value of the key and whether the key is present.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BTree[K, V]) Get(key K) (V, bool) {
+	node, i := tree.search(key)
+	if node == nil {
+		return utils.Zero[V](), false
+	}
+	return node.values[i], true
+}
+
+// Contains checks for the presence of the key in the tree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BTree[K, V]) Contains(key K) bool {
+	node, _ := tree.search(key)
+	return node != nil
+}
+
+// search returns the node holding the key and the index of the key in it, nil if the key is not present.
+func (tree *BTree[K, V]) search(key K) (*btreeNode[K, V], int) {
+	for node := tree.root; node != nil; {
+		i, found := searchKeys(node.keys, key, tree.compare)
+		if found {
+			return node, i
+		}
+		if node.leaf() {
+			return nil, 0
+		}
+		node = node.children[i]
+	}
+	return nil, 0
+}
+
+// Put sets the value of the key, it returns true if the key was added and false if its value was replaced.
+//
+// Full nodes are split on the way down, so the insertion never goes back up the tree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(t*log n), where 't' is the minimum degree.
+//
+// Space complexity: O(t).
+func (tree *BTree[K, V]) Put(key K, value V) bool {
+	tree.lazyInit()
+	if node, i := tree.search(key); node != nil {
+		node.values[i] = value
+		return false
+	}
+
+	if tree.root == nil {
+		tree.root = &btreeNode[K, V]{}
+	}
+
+	if len(tree.root.keys) == 2*tree.t-1 {
+		root := &btreeNode[K, V]{children: []*btreeNode[K, V]{tree.root}}
+		tree.splitChild(root, 0)
+		tree.root = root
+	}
+
+	tree.insertNonFull(tree.root, key, value)
+	tree.size++
+	return true
+}
+
+func (tree *BTree[K, V]) insertNonFull(node *btreeNode[K, V], key K, value V) {
+	for {
+		i, _ := searchKeys(node.keys, key, tree.compare)
+		if node.leaf() {
+			node.keys = insertAt(node.keys, i, key)
+			node.values = insertAt(node.values, i, value)
+			return
+		}
+
+		if len(node.children[i].keys) == 2*tree.t-1 {
+			tree.splitChild(node, i)
+			if order(tree.compare, key, node.keys[i]) > 0 {
+				i++
+			}
+		}
+		node = node.children[i]
+	}
+}
+
+// splitChild splits the full i-th child of the node in two, moving its median key up to the node.
+func (tree *BTree[K, V]) splitChild(node *btreeNode[K, V], i int) {
+	t := tree.t
+	child := node.children[i]
+
+	right := &btreeNode[K, V]{
+		keys:   append([]K(nil), child.keys[t:]...),
+		values: append([]V(nil), child.values[t:]...),
+	}
+	if !child.leaf() {
+		right.children = append([]*btreeNode[K, V](nil), child.children[t:]...)
+		child.children = child.children[:t]
+	}
+
+	node.keys = insertAt(node.keys, i, child.keys[t-1])
+	node.values = insertAt(node.values, i, child.values[t-1])
+	node.children = insertAt(node.children, i+1, right)
+
+	child.keys = child.keys[:t-1]
+	child.values = child.values[:t-1]
+}
+
+// Delete removes the key from the tree, it returns false if the key is not present.
+//
+// Children with the minimum number of keys are refilled on the way down,
+// so the deletion never goes back up the tree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(t*log n), where 't' is the minimum degree.
+//
+// Space complexity: O(t).
+func (tree *BTree[K, V]) Delete(key K) bool {
+	if !tree.Contains(key) {
+		return false
+	}
+
+	tree.delete(tree.root, key)
+	tree.size--
+
+	if len(tree.root.keys) == 0 {
+		if tree.root.leaf() {
+			tree.root = nil
+		} else {
+			tree.root = tree.root.children[0]
+		}
+	}
+	return true
+}
+
+// delete deletes the key from the subtree, the key must be present in it.
+func (tree *BTree[K, V]) delete(node *btreeNode[K, V], key K) {
+	t := tree.t
+	for {
+		i, found := searchKeys(node.keys, key, tree.compare)
+
+		if found && node.leaf() {
+			node.keys = removeAt(node.keys, i)
+			node.values = removeAt(node.values, i)
+			return
+		}
+
+		if found {
+			left, right := node.children[i], node.children[i+1]
+			switch {
+			case len(left.keys) >= t:
+				// Replace the key with its predecessor and delete the predecessor.
+				pred := left
+				for !pred.leaf() {
+					pred = pred.children[len(pred.children)-1]
+				}
+				last := len(pred.keys) - 1
+				node.keys[i], node.values[i] = pred.keys[last], pred.values[last]
+				node, key = left, pred.keys[last]
+			case len(right.keys) >= t:
+				// Replace the key with its successor and delete the successor.
+				succ := right
+				for !succ.leaf() {
+					succ = succ.children[0]
+				}
+				node.keys[i], node.values[i] = succ.keys[0], succ.values[0]
+				node, key = right, succ.keys[0]
+			default:
+				// Both children are minimal, merge them around the key.
+				tree.merge(node, i)
+				node = left
+			}
+			continue
+		}
+
+		if len(node.children[i].keys) == t-1 {
+			i = tree.fill(node, i)
+		}
+		node = node.children[i]
+	}
+}
+
+// fill makes the minimal i-th child of the node hold at least t keys by borrowing a key from a sibling
+// or merging with it, it returns the index of the child holding the keys of the i-th child.
+func (tree *BTree[K, V]) fill(node *btreeNode[K, V], i int) int {
+	t := tree.t
+	child := node.children[i]
+
+	if i > 0 && len(node.children[i-1].keys) >= t {
+		// Rotate the last key of the left sibling through the node.
+		left := node.children[i-1]
+		last := len(left.keys) - 1
+		child.keys = insertAt(child.keys, 0, node.keys[i-1])
+		child.values = insertAt(child.values, 0, node.values[i-1])
+		node.keys[i-1], node.values[i-1] = left.keys[last], left.values[last]
+		left.keys, left.values = left.keys[:last], left.values[:last]
+		if !left.leaf() {
+			child.children = insertAt(child.children, 0, left.children[last+1])
+			left.children = left.children[:last+1]
+		}
+		return i
+	}
+
+	if i < len(node.children)-1 && len(node.children[i+1].keys) >= t {
+		// Rotate the first key of the right sibling through the node.
+		right := node.children[i+1]
+		child.keys = append(child.keys, node.keys[i])
+		child.values = append(child.values, node.values[i])
+		node.keys[i], node.values[i] = right.keys[0], right.values[0]
+		right.keys, right.values = removeAt(right.keys, 0), removeAt(right.values, 0)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+		return i
+	}
+
+	if i < len(node.children)-1 {
+		tree.merge(node, i)
+		return i
+	}
+	tree.merge(node, i-1)
+	return i - 1
+}
+
+// merge merges the (i+1)-th child of the node and the i-th key of the node into the i-th child.
+func (tree *BTree[K, V]) merge(node *btreeNode[K, V], i int) {
+	left, right := node.children[i], node.children[i+1]
+
+	left.keys = append(append(left.keys, node.keys[i]), right.keys...)
+	left.values = append(append(left.values, node.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	node.keys = removeAt(node.keys, i)
+	node.values = removeAt(node.values, i)
+	node.children = removeAt(node.children, i+1)
+}
+
+// Min returns the smallest key with its value, ok is false if the tree is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BTree[K, V]) Min() (K, V, bool) {
+	if tree.root == nil {
+		return utils.Zero[K](), utils.Zero[V](), false
+	}
+
+	node := tree.root
+	for !node.leaf() {
+		node = node.children[0]
+	}
+	return node.keys[0], node.values[0], true
+}
+
+// Max returns the greatest key with its value, ok is false if the tree is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *BTree[K, V]) Max() (K, V, bool) {
+	if tree.root == nil {
+		return utils.Zero[K](), utils.Zero[V](), false
+	}
+
+	node := tree.root
+	for !node.leaf() {
+		node = node.children[len(node.children)-1]
+	}
+	last := len(node.keys) - 1
+	return node.keys[last], node.values[last], true
+}
+
+// Range calls the callback for the keys between lo and hi inclusive with their values in ascending order.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(t*log n + k), where 't' is the minimum degree and 'k' is the number of keys in the range.
+//
+// Space complexity: O(log n).
+func (tree *BTree[K, V]) Range(lo, hi K, callback func(key K, value V)) {
+	tree.traverse(tree.root, &lo, func(key K, value V) bool {
+		if order(tree.compare, key, hi) > 0 {
+			return false
+		}
+		callback(key, value)
+		return true
+	})
+}
+
+// Traverse visits the keys with their values in ascending order until the callback returns false.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(log n).
+func (tree *BTree[K, V]) Traverse(callback func(key K, value V) bool) {
+	tree.traverse(tree.root, nil, callback)
+}
+
+// traverse visits the keys of the subtree not less than lo (all keys if lo is nil) in ascending order,
+// it returns false if the traversal was stopped.
+func (tree *BTree[K, V]) traverse(node *btreeNode[K, V], lo *K, callback func(key K, value V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	i := 0
+	if lo != nil {
+		i, _ = searchKeys(node.keys, *lo, tree.compare)
+	}
+
+	for ; i < len(node.keys); i++ {
+		if !node.leaf() && !tree.traverse(node.children[i], lo, callback) {
+			return false
+		}
+		if !callback(node.keys[i], node.values[i]) {
+			return false
+		}
+	}
+
+	if !node.leaf() {
+		return tree.traverse(node.children[len(node.keys)], lo, callback)
+	}
+	return true
+}
+
+// BulkLoad replaces the content of the tree with the keys and their values,
+// the keys must be sorted in ascending order without duplicates.
+//
+// The tree is built bottom-up with the keys spread evenly between the nodes of each level,
+// which is faster than inserting the keys one by one.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(n).
+func (tree *BTree[K, V]) BulkLoad(keys []K, values []V) error {
+	tree.lazyInit()
+	if err := checkSorted(keys, values, tree.compare); err != nil {
+		return err
+	}
+
+	tree.size = len(keys)
+	if len(keys) == 0 {
+		tree.root = nil
+		return nil
+	}
+
+	// The smallest height which can hold all keys.
+	height := 0
+	for capacity := 2*tree.t - 1; capacity < len(keys); capacity = (capacity+1)*2*tree.t - 1 {
+		height++
+	}
+
+	tree.root = tree.build(keys, values, height)
+	return nil
+}
+
+// build builds the subtree of the given height from the sorted keys, the number of keys must fit
+// between the minimum and the maximum of a subtree of that height (the root may have fewer keys).
+func (tree *BTree[K, V]) build(keys []K, values []V, height int) *btreeNode[K, V] {
+	if height == 0 {
+		return &btreeNode[K, V]{
+			keys:   append([]K(nil), keys...),
+			values: append([]V(nil), values...),
+		}
+	}
+
+	// A child subtree holds at least t^height-1 and at most (2t)^height-1 keys,
+	// taking as many children as the minimum allows keeps every child within both bounds.
+	minKeys := intPow(tree.t, height) - 1
+	children := gmath.Min(2*tree.t, (len(keys)+1)/(minKeys+1))
+
+	node := &btreeNode[K, V]{
+		keys:     make([]K, 0, children-1),
+		values:   make([]V, 0, children-1),
+		children: make([]*btreeNode[K, V], 0, children),
+	}
+
+	// Spread the keys left after taking the separators evenly between the children.
+	perChild, extra := (len(keys)-children+1)/children, (len(keys)-children+1)%children
+	lo := 0
+	for c := 0; c < children; c++ {
+		hi := lo + perChild
+		if c < extra {
+			hi++
+		}
+		node.children = append(node.children, tree.build(keys[lo:hi], values[lo:hi], height-1))
+		if c < children-1 {
+			node.keys = append(node.keys, keys[hi])
+			node.values = append(node.values, values[hi])
+		}
+		lo = hi + 1
+	}
+
+	return node
+}
+
+// searchKeys returns the index of the first key not less than the given key and whether it's equal to the key.
+func searchKeys[K any](keys []K, key K, compare utils.ComparatorFn[K]) (int, bool) {
+	i := sort.Search(len(keys), func(i int) bool {
+		return order(compare, keys[i], key) >= 0
+	})
+	return i, i < len(keys) && order(compare, keys[i], key) == 0
+}
+
+// checkSorted checks the input of bulk loading.
+func checkSorted[K, V any](keys []K, values []V, compare utils.ComparatorFn[K]) error {
+	if len(keys) != len(values) {
+		return ErrKeysValuesMismatch
+	}
+	for i := 1; i < len(keys); i++ {
+		if order(compare, keys[i-1], keys[i]) >= 0 {
+			return ErrKeysNotSorted
+		}
+	}
+	return nil
+}
+
+func insertAt[T any](s []T, i int, value T) []T {
+	s = append(s, utils.Zero[T]())
+	copy(s[i+1:], s[i:])
+	s[i] = value
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = utils.Zero[T]()
+	return s[:len(s)-1]
+}
+
+func intPow(base, exp int) int {
+	result := 1
+	for ; exp > 0; exp-- {
+		result *= base
+	}
+	return result
+}
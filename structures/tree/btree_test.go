@@ -0,0 +1,235 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBTree(t *testing.T) {
+	t.Parallel()
+	tree := NewBTree[int, string](3)
+
+	require.Equal(t, 3, tree.MinDegree())
+	require.Zero(t, tree.Size())
+	require.Zero(t, tree.Height())
+	require.Equal(t, MinDegree, NewBTree[int, string](0).MinDegree())
+
+	_, ok := tree.Get(1)
+	require.False(t, ok)
+	require.False(t, tree.Delete(1))
+	_, _, ok = tree.Min()
+	require.False(t, ok)
+	_, _, ok = tree.Max()
+	require.False(t, ok)
+}
+
+func TestBTreeZeroValue(t *testing.T) {
+	t.Parallel()
+	var tree BTree[int, string]
+	require.Equal(t, MinDegree, tree.MinDegree())
+	_, ok := tree.Get(1)
+	require.False(t, ok)
+
+	for i := 0; i < 10; i++ {
+		require.True(t, tree.Put(i, strconv.Itoa(i)))
+	}
+	require.Equal(t, 10, tree.Size())
+	value, ok := tree.Get(7)
+	require.True(t, ok)
+	require.Equal(t, "7", value)
+
+	require.Panics(t, func() {
+		(&BTree[struct{}, string]{}).Put(struct{}{}, "")
+	})
+}
+
+func TestBTreePutGetDelete(t *testing.T) {
+	t.Parallel()
+	for _, degree := range []int{2, 3, 5} {
+		degree := degree
+		t.Run(strconv.Itoa(degree), func(t *testing.T) {
+			t.Parallel()
+			tree := NewBTree[int, int](degree)
+			present := make(map[int]int)
+
+			rng := rand.New(rand.NewSource(int64(degree)))
+			for i := 0; i < 3000; i++ {
+				key := rng.Intn(500)
+				if rng.Intn(3) == 0 {
+					_, ok := present[key]
+					require.Equal(t, ok, tree.Delete(key), "delete %v", key)
+					delete(present, key)
+				} else {
+					_, ok := present[key]
+					require.Equal(t, !ok, tree.Put(key, i), "put %v", key)
+					present[key] = i
+				}
+
+				if i%100 == 0 {
+					requireBTree(t, tree)
+				}
+			}
+
+			requireBTree(t, tree)
+			require.Equal(t, len(present), tree.Size())
+			for key := 0; key < 500; key++ {
+				value, ok := tree.Get(key)
+				want, has := present[key]
+				require.Equal(t, has, ok)
+				require.Equal(t, want, value)
+			}
+
+			for key := range present {
+				require.True(t, tree.Delete(key))
+			}
+			require.Zero(t, tree.Size())
+			require.Nil(t, tree.root)
+		})
+	}
+}
+
+func TestBTreeRangeTraverse(t *testing.T) {
+	t.Parallel()
+	tree := NewBTree[int, string](2)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(50) {
+		tree.Put(key*2, string(rune('a'+key%26)))
+	}
+
+	var keys []int
+	tree.Range(15, 31, func(key int, value string) {
+		keys = append(keys, key)
+		require.Equal(t, string(rune('a'+(key/2)%26)), value)
+	})
+	require.Equal(t, []int{16, 18, 20, 22, 24, 26, 28, 30}, keys)
+
+	keys = nil
+	tree.Traverse(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return len(keys) < 5
+	})
+	require.Equal(t, []int{0, 2, 4, 6, 8}, keys)
+
+	key, _, ok := tree.Min()
+	require.True(t, ok)
+	require.Equal(t, 0, key)
+	key, _, ok = tree.Max()
+	require.True(t, ok)
+	require.Equal(t, 98, key)
+}
+
+func TestBTreeBulkLoad(t *testing.T) {
+	t.Parallel()
+	for _, degree := range []int{2, 3, 4} {
+		for n := 0; n <= 300; n++ {
+			keys := make([]int, n)
+			values := make([]string, n)
+			for i := range keys {
+				keys[i] = i * 3
+				values[i] = string(rune('a' + i%26))
+			}
+
+			tree := NewBTree[int, string](degree)
+			tree.Put(-1, "replaced")
+			require.NoError(t, tree.BulkLoad(keys, values))
+			requireBTree(t, tree)
+			require.Equal(t, n, tree.Size())
+			require.False(t, tree.Contains(-1))
+
+			got := make([]int, 0, n)
+			tree.Traverse(func(key int, value string) bool {
+				got = append(got, key)
+				return true
+			})
+			require.Equal(t, keys, got, "degree %v, n %v", degree, n)
+
+			// The loaded tree stays valid under mutations.
+			tree.Put(1, "x")
+			if n > 0 {
+				require.True(t, tree.Delete(keys[n/2]))
+			}
+			requireBTree(t, tree)
+		}
+	}
+
+	tree := NewBTree[int, int](2)
+	require.ErrorIs(t, tree.BulkLoad([]int{1, 3, 2}, []int{1, 2, 3}), ErrKeysNotSorted)
+	require.ErrorIs(t, tree.BulkLoad([]int{1, 1}, []int{1, 2}), ErrKeysNotSorted)
+	require.ErrorIs(t, tree.BulkLoad([]int{1, 2}, []int{1}), ErrKeysValuesMismatch)
+}
+
+func TestBTreeWithComparator(t *testing.T) {
+	t.Parallel()
+	// Keys ordered by length, then lexicographically.
+	tree := NewBTreeWithComparator[string, int](2, func(a, b string) int8 {
+		if len(a) != len(b) {
+			return utils.LessComparator(len(a), len(b))
+		}
+		return utils.LessComparator(a, b)
+	})
+	for i, key := range []string{"ccc", "a", "bb", "aa", "b", "dddd"} {
+		tree.Put(key, i)
+	}
+
+	var keys []string
+	tree.Traverse(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []string{"a", "b", "aa", "bb", "ccc", "dddd"}, keys)
+}
+
+// requireBTree checks the key counts, the order of the keys and the depth of the leaves of the B-tree.
+func requireBTree[K, V any](t *testing.T, tree *BTree[K, V]) {
+	t.Helper()
+	if tree.root == nil {
+		require.Zero(t, tree.size)
+		return
+	}
+
+	count := 0
+	leafDepth := -1
+	var check func(node *btreeNode[K, V], depth int)
+	check = func(node *btreeNode[K, V], depth int) {
+		if node != tree.root {
+			require.GreaterOrEqual(t, len(node.keys), tree.t-1, "underflow")
+		}
+		require.LessOrEqual(t, len(node.keys), 2*tree.t-1, "overflow")
+		require.NotEmpty(t, node.keys)
+		require.Len(t, node.values, len(node.keys))
+		require.True(t, sort.SliceIsSorted(node.keys, func(i, j int) bool {
+			return order(tree.compare, node.keys[i], node.keys[j]) < 0
+		}))
+		count += len(node.keys)
+
+		if node.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			require.Equal(t, leafDepth, depth, "leaves at different depths")
+			return
+		}
+
+		require.Len(t, node.children, len(node.keys)+1)
+		for _, child := range node.children {
+			check(child, depth+1)
+		}
+	}
+	check(tree.root, 0)
+
+	require.Equal(t, tree.size, count)
+	require.Equal(t, leafDepth, tree.Height())
+
+	var prev *K
+	tree.Traverse(func(key K, _ V) bool {
+		if prev != nil {
+			require.Negative(t, order(tree.compare, *prev, key), "unordered keys")
+		}
+		prev = &key
+		return true
+	})
+}
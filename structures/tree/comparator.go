@@ -0,0 +1,68 @@
+package tree
+
+import (
+	"fmt"
+
+	"github.com/dkhrunov/dsa-go/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// order returns a negative number if a goes before b by the comparator,
+// zero if they are equal and a positive number otherwise.
+//
+// The comparator returns 1 if a goes before b, e.g. utils.LessComparator orders values ascending.
+// Trees set their comparator when the first value is inserted, see BSTree.lazyInit,
+// a nil comparator is resolved here only for the nodes linked into a zero-value tree directly.
+func order[T any](compare utils.ComparatorFn[T], a, b T) int {
+	if compare == nil {
+		compare = orderedComparator[T]()
+	}
+	return -int(compare(a, b))
+}
+
+// orderedComparator returns utils.LessComparator for T, which is used by the zero values of the trees.
+//
+// T must be one of the predeclared integer, float or string types, it panics otherwise,
+// e.g. for structs or named types like time.Duration, whose trees must be created with a comparator.
+// The type of T is checked once, so the returned comparator doesn't add any cost per comparison.
+func orderedComparator[T any]() utils.ComparatorFn[T] {
+	var compare any
+	switch any(*new(T)).(type) {
+	case int:
+		compare = lessComparator[int]()
+	case int8:
+		compare = lessComparator[int8]()
+	case int16:
+		compare = lessComparator[int16]()
+	case int32:
+		compare = lessComparator[int32]()
+	case int64:
+		compare = lessComparator[int64]()
+	case uint:
+		compare = lessComparator[uint]()
+	case uint8:
+		compare = lessComparator[uint8]()
+	case uint16:
+		compare = lessComparator[uint16]()
+	case uint32:
+		compare = lessComparator[uint32]()
+	case uint64:
+		compare = lessComparator[uint64]()
+	case uintptr:
+		compare = lessComparator[uintptr]()
+	case float32:
+		compare = lessComparator[float32]()
+	case float64:
+		compare = lessComparator[float64]()
+	case string:
+		compare = lessComparator[string]()
+	default:
+		panic(fmt.Sprintf("tree: values of type %T have no built-in order, create the tree with a comparator", *new(T)))
+	}
+
+	return compare.(utils.ComparatorFn[T])
+}
+
+func lessComparator[T constraints.Ordered]() utils.ComparatorFn[T] {
+	return utils.LessComparator[T]
+}
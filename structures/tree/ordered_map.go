@@ -0,0 +1,239 @@
+package tree
+
+import (
+	"github.com/dkhrunov/dsa-go/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// entry is a key-value pair of OrderedMap, entries are ordered by their keys.
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap is a map which keeps its keys sorted, it's backed by an AVL tree,
+// so lookups and updates take O(log n) time.
+//
+// The zero value is an empty map, which orders keys with the built-in operators,
+// putting into it panics unless K is a predeclared integer, float or string type.
+type OrderedMap[K, V any] struct {
+	tree AVLTree[entry[K, V]]
+}
+
+// NewOrderedMap creates a new map with ordered keys.
+func NewOrderedMap[K constraints.Ordered, V any]() *OrderedMap[K, V] {
+	return NewOrderedMapWithComparator[K, V](utils.LessComparator[K])
+}
+
+// NewOrderedMapWithComparator creates a new map with keys ordered by the comparator,
+// see NewBSTWithComparator for the contract of the comparator.
+func NewOrderedMapWithComparator[K, V any](compare utils.ComparatorFn[K]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	if compare != nil {
+		m.tree.compare = entryComparator[V](compare)
+	}
+	return m
+}
+
+// entryComparator orders the entries by their keys.
+func entryComparator[V, K any](compare utils.ComparatorFn[K]) utils.ComparatorFn[entry[K, V]] {
+	return func(a, b entry[K, V]) int8 {
+		return compare(a.key, b.key)
+	}
+}
+
+// lazyInit sets the built-in order of the keys of a zero-value map before its first key is added,
+// the comparator isn't used while the map is empty.
+func (m *OrderedMap[K, V]) lazyInit() {
+	if m.tree.compare == nil {
+		m.tree.compare = entryComparator[V](orderedComparator[K]())
+	}
+}
+
+// Size returns the number of keys in the map.
+func (m *OrderedMap[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+// Put sets the value of the key, it returns true if the key was added and false if its value was replaced.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (m *OrderedMap[K, V]) Put(key K, value V) bool {
+	m.lazyInit()
+	if node := m.tree.Search(entry[K, V]{key: key}); node != nil {
+		node.value.value = value
+		return false
+	}
+
+	return m.tree.Insert(entry[K, V]{key: key, value: value})
+}
+
+// Get returns the value of the key and whether the key is present.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	node := m.tree.Search(entry[K, V]{key: key})
+	if node == nil {
+		return utils.Zero[V](), false
+	}
+	return node.value.value, true
+}
+
+// Contains checks for the presence of the key in the map.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	return m.tree.Contains(entry[K, V]{key: key})
+}
+
+// Delete removes the key from the map, it returns false if the key is not present.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	return m.tree.Delete(entry[K, V]{key: key})
+}
+
+// Floor returns the greatest key less than or equal to the given key with its value,
+// ok is false if there is no such key.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (m *OrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	return unwrapEntry(m.tree.floor(entry[K, V]{key: key}))
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key with its value,
+// ok is false if there is no such key.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (m *OrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return unwrapEntry(m.tree.ceiling(entry[K, V]{key: key}))
+}
+
+// Min returns the smallest key with its value, ok is false if the map is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (m *OrderedMap[K, V]) Min() (K, V, bool) {
+	node := m.tree.root
+	for node != nil && node.left != nil {
+		node = node.left
+	}
+	return unwrapEntry(node)
+}
+
+// Max returns the greatest key with its value, ok is false if the map is empty.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (m *OrderedMap[K, V]) Max() (K, V, bool) {
+	node := m.tree.root
+	for node != nil && node.right != nil {
+		node = node.right
+	}
+	return unwrapEntry(node)
+}
+
+// Traverse visits the keys with their values in ascending order of the keys
+// until the callback returns false.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(log n).
+func (m *OrderedMap[K, V]) Traverse(callback func(key K, value V) bool) {
+	m.tree.traverseInorder(m.tree.root, func(node *AVLNode[entry[K, V]]) bool {
+		return callback(node.value.key, node.value.value)
+	})
+}
+
+// Keys returns the keys in ascending order.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(n).
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	m.Traverse(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values in ascending order of their keys.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(n).
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	m.Traverse(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+func unwrapEntry[K, V any](node *AVLNode[entry[K, V]]) (K, V, bool) {
+	if node == nil {
+		return utils.Zero[K](), utils.Zero[V](), false
+	}
+	return node.value.key, node.value.value, true
+}
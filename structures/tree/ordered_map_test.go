@@ -0,0 +1,138 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderedMapPutGetDelete(t *testing.T) {
+	t.Parallel()
+	m := NewOrderedMap[string, int]()
+
+	require.True(t, m.Put("b", 2))
+	require.True(t, m.Put("a", 1))
+	require.True(t, m.Put("c", 3))
+	require.False(t, m.Put("b", 20))
+	require.Equal(t, 3, m.Size())
+
+	value, ok := m.Get("b")
+	require.True(t, ok)
+	require.Equal(t, 20, value)
+
+	_, ok = m.Get("d")
+	require.False(t, ok)
+
+	require.True(t, m.Delete("a"))
+	require.False(t, m.Delete("a"))
+	require.False(t, m.Contains("a"))
+	require.Equal(t, []string{"b", "c"}, m.Keys())
+	require.Equal(t, []int{20, 3}, m.Values())
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	t.Parallel()
+	var m OrderedMap[int, string]
+	require.False(t, m.Contains(1))
+
+	require.True(t, m.Put(2, "b"))
+	require.True(t, m.Put(1, "a"))
+	require.Equal(t, []int{1, 2}, m.Keys())
+
+	// A nil comparator orders the keys with the built-in operators too.
+	withNil := NewOrderedMapWithComparator[int, string](nil)
+	require.True(t, withNil.Put(2, "b"))
+	require.True(t, withNil.Put(1, "a"))
+	require.Equal(t, []int{1, 2}, withNil.Keys())
+
+	require.Panics(t, func() {
+		(&OrderedMap[struct{}, string]{}).Put(struct{}{}, "")
+	})
+}
+
+func TestOrderedMapFloorCeiling(t *testing.T) {
+	t.Parallel()
+	m := NewOrderedMap[int, string]()
+	for _, key := range []int{10, 20, 30, 40} {
+		m.Put(key, string(rune('a'+key/10-1)))
+	}
+
+	tests := []struct {
+		key          int
+		floor        int
+		floorValue   string
+		floorOk      bool
+		ceiling      int
+		ceilingValue string
+		ceilingOk    bool
+	}{
+		{key: 5, ceiling: 10, ceilingOk: true, ceilingValue: "a"},
+		{key: 10, floor: 10, floorOk: true, floorValue: "a", ceiling: 10, ceilingOk: true, ceilingValue: "a"},
+		{key: 25, floor: 20, floorOk: true, floorValue: "b", ceiling: 30, ceilingOk: true, ceilingValue: "c"},
+		{key: 45, floor: 40, floorOk: true, floorValue: "d"},
+	}
+
+	for _, tt := range tests {
+		key, value, ok := m.Floor(tt.key)
+		require.Equal(t, tt.floorOk, ok, "floor of %v", tt.key)
+		require.Equal(t, tt.floor, key, "floor of %v", tt.key)
+		require.Equal(t, tt.floorValue, value, "floor of %v", tt.key)
+
+		key, value, ok = m.Ceiling(tt.key)
+		require.Equal(t, tt.ceilingOk, ok, "ceiling of %v", tt.key)
+		require.Equal(t, tt.ceiling, key, "ceiling of %v", tt.key)
+		require.Equal(t, tt.ceilingValue, value, "ceiling of %v", tt.key)
+	}
+}
+
+func TestOrderedMapMinMax(t *testing.T) {
+	t.Parallel()
+	m := NewOrderedMap[int, int]()
+
+	_, _, ok := m.Min()
+	require.False(t, ok)
+	_, _, ok = m.Max()
+	require.False(t, ok)
+
+	rng := rand.New(rand.NewSource(1))
+	keys := rng.Perm(100)
+	for _, key := range keys {
+		m.Put(key, key*key)
+	}
+	require.True(t, isBalance(t, m.tree.Root()))
+
+	key, value, ok := m.Min()
+	require.True(t, ok)
+	require.Equal(t, 0, key)
+	require.Equal(t, 0, value)
+
+	key, value, ok = m.Max()
+	require.True(t, ok)
+	require.Equal(t, 99, key)
+	require.Equal(t, 99*99, value)
+
+	sort.Ints(keys)
+	require.Equal(t, keys, m.Keys())
+}
+
+func TestOrderedMapTraverse(t *testing.T) {
+	t.Parallel()
+	// Keys in descending order.
+	m := NewOrderedMapWithComparator[int, string](utils.GreaterComparator[int])
+	for i, value := range []string{"zero", "one", "two", "three", "four"} {
+		m.Put(i, value)
+	}
+
+	var keys []int
+	var values []string
+	m.Traverse(func(key int, value string) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+		return key > 2
+	})
+	require.Equal(t, []int{4, 3, 2}, keys)
+	require.Equal(t, []string{"four", "three", "two"}, values)
+}
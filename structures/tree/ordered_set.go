@@ -0,0 +1,24 @@
+package tree
+
+// OrderedSet is a set of distinct values kept in ascending order,
+// it's implemented by BSTree, AVLTree and RedBlackTree.
+type OrderedSet[T any] interface {
+	// Insert adds the value, it returns false if the value is already present.
+	Insert(value T) bool
+	// Delete removes the value, it returns false if the value is not present.
+	Delete(value T) bool
+	Contains(value T) bool
+	Size() int
+	Height() int
+	Floor(value T) (T, bool)
+	Ceiling(value T) (T, bool)
+	Range(lo, hi T, callback func(value T))
+	Rank(value T) int
+	Select(k int) (T, bool)
+}
+
+var (
+	_ OrderedSet[int] = (*BSTree[int])(nil)
+	_ OrderedSet[int] = (*AVLTree[int])(nil)
+	_ OrderedSet[int] = (*RedBlackTree[int])(nil)
+)
@@ -0,0 +1,61 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+const benchmarkSetSize = 10000
+
+func BenchmarkOrderedSetInsert(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(benchmarkSetSize)
+	for _, tt := range orderedSets() {
+		tt := tt
+		b.Run(tt.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				insertAll(tt.newSet(b), values)
+			}
+		})
+	}
+}
+
+func BenchmarkOrderedSetContains(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(benchmarkSetSize)
+	for _, tt := range orderedSets() {
+		set := insertAll(tt.newSet(b), values)
+		b.Run(tt.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				set.Contains(values[i%benchmarkSetSize])
+			}
+		})
+	}
+}
+
+func BenchmarkOrderedSetDelete(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(benchmarkSetSize)
+	for _, tt := range orderedSets() {
+		tt := tt
+		b.Run(tt.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				set := insertAll(tt.newSet(b), values)
+				b.StartTimer()
+
+				deleteAll(set, values)
+			}
+		})
+	}
+}
+
+func insertAll(set OrderedSet[int], values []int) OrderedSet[int] {
+	for _, value := range values {
+		set.Insert(value)
+	}
+	return set
+}
+
+func deleteAll(set OrderedSet[int], values []int) {
+	for _, value := range values {
+		set.Delete(value)
+	}
+}
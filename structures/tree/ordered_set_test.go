@@ -0,0 +1,58 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// orderedSet is the factory of empty sets of an OrderedSet implementation.
+type orderedSet struct {
+	name   string
+	newSet treeFactory[OrderedSet[int]]
+}
+
+// orderedSets returns the factories of every OrderedSet implementation.
+func orderedSets() []orderedSet {
+	return []orderedSet{
+		{name: "BST", newSet: treeOf(func() OrderedSet[int] { return NewBST[int]() })},
+		{name: "AVLTree", newSet: treeOf(func() OrderedSet[int] { return NewAVLTree[int]() })},
+		{name: "RedBlackTree", newSet: treeOf(func() OrderedSet[int] { return NewRedBlackTree[int]() })},
+	}
+}
+
+func TestOrderedSet(t *testing.T) {
+	t.Parallel()
+	for _, tt := range orderedSets() {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			set := tt.newSet(t)
+			require.Zero(t, set.Size())
+			require.Zero(t, set.Height())
+
+			for _, value := range []int{50, 30, 70, 20, 40, 60, 80} {
+				require.True(t, set.Insert(value))
+			}
+			require.False(t, set.Insert(40))
+			require.Equal(t, 7, set.Size())
+			require.Equal(t, 2, set.Height())
+
+			require.True(t, set.Delete(30))
+			require.False(t, set.Delete(30))
+			require.False(t, set.Contains(30))
+			require.True(t, set.Contains(20))
+
+			var values []int
+			set.Range(0, 100, func(value int) {
+				values = append(values, value)
+			})
+			require.Equal(t, []int{20, 40, 50, 60, 70, 80}, values)
+			require.Equal(t, 2, set.Rank(45))
+
+			value, ok := set.Select(3)
+			require.True(t, ok)
+			require.Equal(t, 60, value)
+		})
+	}
+}
@@ -0,0 +1,652 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dkhrunov/dsa-go/gmath"
+	"github.com/dkhrunov/dsa-go/utils"
+	"golang.org/x/exp/constraints"
+)
+
+type RBNode[T any] struct {
+	value T
+	red   bool
+	// The number of nodes in the subtree rooted at the node.
+	size        int
+	left, right *RBNode[T]
+}
+
+// Value return the value of node.
+func (node *RBNode[T]) Value() T {
+	return node.value
+}
+
+// IsRed reports whether the link from the parent to the node is red, nil nodes are black.
+func (node *RBNode[T]) IsRed() bool {
+	return node != nil && node.red
+}
+
+// Left return the left child of node.
+func (node *RBNode[T]) Left() *RBNode[T] {
+	if node == nil {
+		return nil
+	}
+	return node.left
+}
+
+// Right return the right child of node.
+func (node *RBNode[T]) Right() *RBNode[T] {
+	if node == nil {
+		return nil
+	}
+	return node.right
+}
+
+// RedBlackTree is a left-leaning red-black tree, a self-balancing binary search tree
+// which keeps every path from the root to a leaf with the same number of black links,
+// so its height is at most 2*log(n).
+//
+// The zero value is an empty tree, which orders values with the built-in operators,
+// inserting into it panics unless T is a predeclared integer, float or string type.
+type RedBlackTree[T any] struct {
+	// The root node of the red-black tree.
+	root *RBNode[T]
+	// Orders the values of the tree.
+	compare utils.ComparatorFn[T]
+}
+
+// NewRedBlackTree creates a new red-black tree of ordered values.
+func NewRedBlackTree[T constraints.Ordered]() *RedBlackTree[T] {
+	return NewRedBlackTreeWithComparator(utils.LessComparator[T])
+}
+
+// NewRedBlackTreeWithComparator creates a new red-black tree ordered by the comparator,
+// see NewBSTWithComparator for the contract of the comparator.
+func NewRedBlackTreeWithComparator[T any](compare utils.ComparatorFn[T]) *RedBlackTree[T] {
+	return &RedBlackTree[T]{root: nil, compare: compare}
+}
+
+// Root returns the root of the RedBlackTree.
+func (tree *RedBlackTree[T]) Root() *RBNode[T] {
+	return tree.root
+}
+
+// Size returns the number of nodes in the tree.
+// lazyInit sets the built-in order of a zero-value tree before its first value is added,
+// the comparator isn't used while the tree is empty.
+func (tree *RedBlackTree[T]) lazyInit() {
+	if tree.compare == nil {
+		tree.compare = orderedComparator[T]()
+	}
+}
+
+func (tree *RedBlackTree[T]) Size() int {
+	return rbSize(tree.root)
+}
+
+// Height the height of a rooted tree is the number of edges between the tree's
+// root and its furthest leaf. This means that a tree containing a single
+// node has a height of 0.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n), heights are not stored in the nodes.
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) Height() int {
+	if tree.root == nil {
+		return 0
+	}
+	return rbHeight(tree.root)
+}
+
+func rbHeight[T any](node *RBNode[T]) int {
+	if node == nil {
+		return -1
+	}
+	return 1 + gmath.Max(rbHeight(node.left), rbHeight(node.right))
+}
+
+// rbSize returns the number of nodes in the subtree, 0 for an empty subtree.
+func rbSize[T any](node *RBNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// Insert inserts a new value to RedBlackTree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) Insert(value T) bool {
+	tree.lazyInit()
+	if tree.contains(tree.root, value) {
+		return false
+	}
+
+	tree.root = tree.insert(tree.root, value)
+	tree.root.red = false
+	return true
+}
+
+// insert inserts a value inside the red-black tree.
+func (tree *RedBlackTree[T]) insert(node *RBNode[T], value T) *RBNode[T] {
+	// Base case, a new node is linked with a red link.
+	if node == nil {
+		return &RBNode[T]{value: value, red: true, size: 1}
+	}
+
+	cmp := order(tree.compare, value, node.value)
+	if cmp < 0 {
+		node.left = tree.insert(node.left, value)
+	} else if cmp > 0 {
+		node.right = tree.insert(node.right, value)
+	}
+
+	return tree.balance(node)
+}
+
+// Delete removes value from RedBlackTree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) Delete(value T) bool {
+	if !tree.contains(tree.root, value) {
+		return false
+	}
+
+	// The root is temporarily made red, so the deletion can push a red link down the search path.
+	if !tree.root.left.IsRed() && !tree.root.right.IsRed() {
+		tree.root.red = true
+	}
+
+	tree.root = tree.delete(tree.root, value)
+	if tree.root != nil {
+		tree.root.red = false
+	}
+	return true
+}
+
+// delete deletes a value from the red-black tree, the value must be present in the subtree.
+// The search path keeps the current node or one of its children red,
+// so the deleted node is never a black leaf.
+func (tree *RedBlackTree[T]) delete(node *RBNode[T], value T) *RBNode[T] {
+	if order(tree.compare, value, node.value) < 0 {
+		if !node.left.IsRed() && !node.left.left.IsRed() {
+			node = tree.moveRedLeft(node)
+		}
+		node.left = tree.delete(node.left, value)
+	} else {
+		if node.left.IsRed() {
+			node = tree.rightRotate(node)
+		}
+
+		// Found the node we wish to remove, which has no right subtree,
+		// so it's a red leaf.
+		if order(tree.compare, value, node.value) == 0 && node.right == nil {
+			return nil
+		}
+
+		if !node.right.IsRed() && !node.right.left.IsRed() {
+			node = tree.moveRedRight(node)
+		}
+
+		if order(tree.compare, value, node.value) == 0 {
+			// Swap the value of the successor into the node
+			// and remove the successor from the right subtree.
+			successor := node.right
+			for successor.left != nil {
+				successor = successor.left
+			}
+			node.value = successor.value
+			node.right = tree.deleteMin(node.right)
+		} else {
+			node.right = tree.delete(node.right, value)
+		}
+	}
+
+	return tree.balance(node)
+}
+
+// deleteMin deletes the leftmost node of the subtree.
+func (tree *RedBlackTree[T]) deleteMin(node *RBNode[T]) *RBNode[T] {
+	if node.left == nil {
+		return nil
+	}
+
+	if !node.left.IsRed() && !node.left.left.IsRed() {
+		node = tree.moveRedLeft(node)
+	}
+
+	node.left = tree.deleteMin(node.left)
+	return tree.balance(node)
+}
+
+// moveRedLeft makes the left child of the node or one of its children red,
+// assuming that the node is red and both its children are black.
+func (tree *RedBlackTree[T]) moveRedLeft(node *RBNode[T]) *RBNode[T] {
+	tree.flipColors(node)
+	if node.right.left.IsRed() {
+		node.right = tree.rightRotate(node.right)
+		node = tree.leftRotate(node)
+		tree.flipColors(node)
+	}
+	return node
+}
+
+// moveRedRight makes the right child of the node or one of its children red,
+// assuming that the node is red and both its children are black.
+func (tree *RedBlackTree[T]) moveRedRight(node *RBNode[T]) *RBNode[T] {
+	tree.flipColors(node)
+	if node.left.left.IsRed() {
+		node = tree.rightRotate(node)
+		tree.flipColors(node)
+	}
+	return node
+}
+
+// balance restores the left-leaning invariants of the node and updates its subtree size.
+func (tree *RedBlackTree[T]) balance(node *RBNode[T]) *RBNode[T] {
+	// Right-leaning red link
+	if node.right.IsRed() && !node.left.IsRed() {
+		node = tree.leftRotate(node)
+	}
+
+	// Two red links in a row
+	if node.left.IsRed() && node.left.left.IsRed() {
+		node = tree.rightRotate(node)
+	}
+
+	// Temporary 4-node
+	if node.left.IsRed() && node.right.IsRed() {
+		tree.flipColors(node)
+	}
+
+	node.size = 1 + rbSize(node.left) + rbSize(node.right)
+	return node
+}
+
+// leftRotate turns the right-leaning red link of the node to lean left and return new root
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(1).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) leftRotate(n *RBNode[T]) *RBNode[T] {
+	b := n.right
+	n.right = b.left
+	b.left = n
+
+	b.red = n.red
+	n.red = true
+
+	b.size = n.size
+	n.size = 1 + rbSize(n.left) + rbSize(n.right)
+
+	return b
+}
+
+// rightRotate turns the left-leaning red link of the node to lean right and return new root
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(1).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) rightRotate(n *RBNode[T]) *RBNode[T] {
+	b := n.left
+	n.left = b.right
+	b.right = n
+
+	b.red = n.red
+	n.red = true
+
+	b.size = n.size
+	n.size = 1 + rbSize(n.left) + rbSize(n.right)
+
+	return b
+}
+
+// flipColors flips the colors of the node and its children.
+func (tree *RedBlackTree[T]) flipColors(node *RBNode[T]) {
+	node.red = !node.red
+	node.left.red = !node.left.red
+	node.right.red = !node.right.red
+}
+
+// Search search given value in RedBlackTree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Search(value T) *RBNode[T] {
+	node := tree.root
+	for node != nil {
+		cmp := order(tree.compare, value, node.value)
+		if cmp < 0 {
+			node = node.left
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			return node
+		}
+	}
+	return nil
+}
+
+// Contains checks for the presence of a value in the RedBlackTree.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Contains(value T) bool {
+	return tree.contains(tree.root, value)
+}
+
+func (tree *RedBlackTree[T]) contains(node *RBNode[T], value T) bool {
+	for node != nil {
+		cmp := order(tree.compare, value, node.value)
+		if cmp < 0 {
+			node = node.left
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+// Floor returns the greatest value less than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Floor(value T) (T, bool) {
+	var floor *RBNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node.value, true
+		} else if cmp < 0 {
+			node = node.left
+		} else {
+			floor = node
+			node = node.right
+		}
+	}
+
+	if floor == nil {
+		return utils.Zero[T](), false
+	}
+	return floor.value, true
+}
+
+// Ceiling returns the smallest value greater than or equal to the given value,
+// ok is false if there is no such value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Ceiling(value T) (T, bool) {
+	var ceiling *RBNode[T]
+	for node := tree.root; node != nil; {
+		cmp := order(tree.compare, value, node.value)
+		if cmp == 0 {
+			return node.value, true
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			ceiling = node
+			node = node.left
+		}
+	}
+
+	if ceiling == nil {
+		return utils.Zero[T](), false
+	}
+	return ceiling.value, true
+}
+
+// Range calls the callback for the values between lo and hi inclusive in ascending order.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n + k), where 'k' is the number of values in the range.
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) Range(lo, hi T, callback func(value T)) {
+	tree.rangeValues(tree.root, lo, hi, callback)
+}
+
+func (tree *RedBlackTree[T]) rangeValues(node *RBNode[T], lo, hi T, callback func(value T)) {
+	if node == nil {
+		return
+	}
+
+	afterLo := order(tree.compare, lo, node.value) <= 0
+	beforeHi := order(tree.compare, node.value, hi) <= 0
+
+	if afterLo {
+		tree.rangeValues(node.left, lo, hi, callback)
+	}
+	if afterLo && beforeHi {
+		callback(node.value)
+	}
+	if beforeHi {
+		tree.rangeValues(node.right, lo, hi, callback)
+	}
+}
+
+// Rank returns the number of values less than the given value.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Rank(value T) int {
+	rank := 0
+	for node := tree.root; node != nil; {
+		if order(tree.compare, value, node.value) <= 0 {
+			node = node.left
+		} else {
+			rank += rbSize(node.left) + 1
+			node = node.right
+		}
+	}
+	return rank
+}
+
+// Select returns the k-th smallest value counting from 0, ok is false if k is out of range.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(log n).
+//
+// Space complexity: O(1).
+func (tree *RedBlackTree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= tree.Size() {
+		return utils.Zero[T](), false
+	}
+
+	node := tree.root
+	for {
+		left := rbSize(node.left)
+		if k < left {
+			node = node.left
+		} else if k > left {
+			k -= left + 1
+			node = node.right
+		} else {
+			return node.value, true
+		}
+	}
+}
+
+// TraversePreorder traverses in preorder of traversal of a binary tree.
+//
+// DFS (Deep First Search) algorithm.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) TraversePreorder(callback func(t *RBNode[T]), empty func()) {
+	tree.traversePreorder(tree.root, callback, empty)
+}
+
+func (tree *RedBlackTree[T]) traversePreorder(node *RBNode[T], callback func(node *RBNode[T]), empty func()) {
+	if node == nil {
+		empty()
+		return
+	}
+	callback(node)
+	tree.traversePreorder(node.left, callback, empty)
+	tree.traversePreorder(node.right, callback, empty)
+}
+
+// TraverseInorder traverses in inorder of traversal of a binary tree, i.e. in ascending order of the values.
+//
+// DFS (Deep First Search) algorithm.
+//
+// --------------------------------------------------
+//
+// Complexity:
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) TraverseInorder(callback func(t *RBNode[T]), empty func()) {
+	tree.traverseInorder(tree.root, callback, empty)
+}
+
+func (tree *RedBlackTree[T]) traverseInorder(node *RBNode[T], callback func(node *RBNode[T]), empty func()) {
+	if node == nil {
+		empty()
+		return
+	}
+	tree.traverseInorder(node.left, callback, empty)
+	callback(node)
+	tree.traverseInorder(node.right, callback, empty)
+}
+
+// Serialize serializes binary tree.
+// The function Serialize() is similar to the preorder traversal of the tree.
+//
+// Special characters "^" and "#":
+// Each node is preceded by a "^" to signify the beginning.
+// If the node has no left or right child, a "#" sign is added.
+//
+// Colors are not serialized.
+//
+// --------------------------------------------------
+//
+// Complexity:
+// Complexity same as DFS algorithm.
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(log n).
+func (tree *RedBlackTree[T]) Serialize() string {
+	if tree.root == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	tree.TraversePreorder(
+		func(t *RBNode[T]) {
+			sb.WriteString(SerializationStart)
+			sb.WriteString(fmt.Sprintf("%v", t.value))
+			sb.WriteString(SerializationDelimiter)
+		},
+		func() {
+			sb.WriteString(SerializationEnd)
+			sb.WriteString(SerializationDelimiter)
+		},
+	)
+
+	return sb.String()
+}
+
+// Deserialize deserializes the serialized before binary tree string and create a binary tree from the passed string,
+// all nodes of the created tree are black.
+//
+// --------------------------------------------------
+//
+// Complexity:
+// Complexity same as DFS algorithm.
+//
+// Time complexity: O(n).
+//
+// Space complexity: O(h), where 'h' is the height of tree, if we do consider the stack size for function calls.
+// Otherwise, the space complexity of inorder traversal is O(1).
+func (tree *RedBlackTree[T]) Deserialize(str string) *RBNode[string] {
+	if len(str) == 0 {
+		return &RBNode[string]{value: utils.Zero[string](), size: 1}
+	}
+
+	var dfs func() *RBNode[string]
+
+	tokens := strings.Split(str, SerializationDelimiter)
+	dfs = func() *RBNode[string] {
+		token := strings.TrimPrefix(tokens[0], SerializationStart)
+		tokens = tokens[1:]
+		if token == SerializationEnd {
+			return nil
+		}
+		node := &RBNode[string]{value: token}
+		node.left = dfs()
+		node.right = dfs()
+		node.size = 1 + rbSize(node.left) + rbSize(node.right)
+		return node
+	}
+
+	return dfs()
+}
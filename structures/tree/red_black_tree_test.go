@@ -0,0 +1,175 @@
+package tree
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dkhrunov/dsa-go/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedBlackTree(t *testing.T) {
+	t.Parallel()
+	rb := NewRedBlackTree[int]()
+
+	require.NotNil(t, rb)
+	require.Zero(t, rb.root)
+	require.Zero(t, rb.Size())
+	require.Zero(t, rb.Height())
+}
+
+func TestRedBlackTreeInsertDelete(t *testing.T) {
+	t.Parallel()
+	rb := NewRedBlackTree[int]()
+	present := make(map[int]bool)
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		value := rng.Intn(300)
+		if rng.Intn(3) == 0 {
+			require.Equal(t, present[value], rb.Delete(value), "delete %v", value)
+			delete(present, value)
+		} else {
+			require.Equal(t, !present[value], rb.Insert(value), "insert %v", value)
+			present[value] = true
+		}
+
+		if i%50 == 0 {
+			requireRedBlack(t, rb)
+		}
+	}
+
+	requireRedBlack(t, rb)
+	require.Equal(t, len(present), rb.Size())
+	for value := 0; value < 300; value++ {
+		require.Equal(t, present[value], rb.Contains(value))
+		if present[value] {
+			require.Equal(t, value, rb.Search(value).Value())
+		} else {
+			require.Nil(t, rb.Search(value))
+		}
+	}
+
+	for value := range present {
+		require.True(t, rb.Delete(value))
+	}
+	require.Zero(t, rb.Size())
+	require.Nil(t, rb.Root())
+}
+
+func TestRedBlackTreeHeight(t *testing.T) {
+	t.Parallel()
+	rb := NewRedBlackTree[int]()
+	require.True(t, rb.Insert(1))
+	require.Zero(t, rb.Height())
+
+	// Sorted insertion degenerates a BST, but not a red-black tree.
+	const n = 1 << 12
+	for i := 2; i <= n; i++ {
+		rb.Insert(i)
+	}
+	require.LessOrEqual(t, float64(rb.Height()), 2*math.Log2(n))
+	requireRedBlack(t, rb)
+}
+
+func TestRedBlackTreeOrderStatistics(t *testing.T) {
+	t.Parallel()
+	rb := NewRedBlackTree[int]()
+	requireOrderStatistics(t, rb)
+	requireRedBlack(t, rb)
+}
+
+func TestRedBlackTreeTraversals(t *testing.T) {
+	t.Parallel()
+	rb := NewRedBlackTree[int]()
+	for _, value := range []int{1, 2, 3, 4, 5} {
+		rb.Insert(value)
+	}
+
+	var inorder []int
+	rb.TraverseInorder(func(node *RBNode[int]) {
+		inorder = append(inorder, node.Value())
+	}, func() {})
+	require.Equal(t, []int{1, 2, 3, 4, 5}, inorder)
+
+	// Left-leaning tree: 4 is the root, 2 is a red left child of 4, 5 is the black right child.
+	require.Equal(t, "^4,^2,^1,#,#,^3,#,#,^5,#,#,", rb.Serialize())
+	require.True(t, rb.Root().Left().IsRed())
+	require.False(t, rb.Root().Right().IsRed())
+
+	root := rb.Deserialize(rb.Serialize())
+	require.Equal(t, "4", root.Value())
+	require.Equal(t, "2", root.Left().Value())
+	require.Equal(t, "3", root.Left().Right().Value())
+	require.Equal(t, 5, root.size)
+
+	require.Empty(t, NewRedBlackTree[int]().Serialize())
+}
+
+func TestRedBlackTreeZeroValue(t *testing.T) {
+	t.Parallel()
+	rb := &RedBlackTree[string]{}
+	for _, s := range []string{"b", "c", "a"} {
+		require.True(t, rb.Insert(s))
+	}
+	require.False(t, rb.Insert("b"))
+	require.True(t, rb.Contains("a"))
+	require.True(t, rb.Delete("a"))
+	require.False(t, rb.Contains("a"))
+}
+
+func TestNewRedBlackTreeWithComparator(t *testing.T) {
+	t.Parallel()
+	type user struct {
+		id   int
+		name string
+	}
+	rb := NewRedBlackTreeWithComparator(func(a, b user) int8 {
+		return utils.LessComparator(a.name, b.name)
+	})
+
+	for i, name := range []string{"carol", "alice", "dave", "bob"} {
+		require.True(t, rb.Insert(user{i, name}))
+	}
+	require.False(t, rb.Insert(user{name: "bob"}))
+
+	first, ok := rb.Select(0)
+	require.True(t, ok)
+	require.Equal(t, user{1, "alice"}, first)
+
+	floor, ok := rb.Floor(user{name: "c"})
+	require.True(t, ok)
+	require.Equal(t, "bob", floor.name)
+}
+
+// requireRedBlack checks the invariants of the left-leaning red-black tree and the subtree sizes.
+func requireRedBlack[T any](t *testing.T, tree *RedBlackTree[T]) {
+	t.Helper()
+	require.False(t, tree.root.IsRed(), "red root")
+
+	var check func(node *RBNode[T]) int
+	check = func(node *RBNode[T]) int {
+		if node == nil {
+			return 0
+		}
+		require.False(t, node.right.IsRed(), "red right link")
+		require.False(t, node.IsRed() && node.left.IsRed(), "two red links in a row")
+		if node.left != nil {
+			require.Negative(t, order(tree.compare, node.left.value, node.value), "unordered left child")
+		}
+		if node.right != nil {
+			require.Positive(t, order(tree.compare, node.right.value, node.value), "unordered right child")
+		}
+
+		left, right := check(node.left), check(node.right)
+		require.Equal(t, left, right, "unequal black heights")
+		require.Equal(t, 1+rbSize(node.left)+rbSize(node.right), node.size)
+
+		if node.IsRed() {
+			return left
+		}
+		return left + 1
+	}
+	check(tree.root)
+}
@@ -7,7 +7,7 @@ import "golang.org/x/exp/constraints"
 // `-1` - compare not passed
 //
 // `0` - values are equal
-type ComparatorFn[T constraints.Ordered] func(a, b T) int8
+type ComparatorFn[T any] func(a, b T) int8
 
 func LessComparator[T constraints.Ordered](a, b T) int8 {
 	if a == b {